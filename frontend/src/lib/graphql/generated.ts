@@ -15,6 +15,7 @@ export type Scalars = {
   Int: { input: number; output: number; }
   Float: { input: number; output: number; }
   Time: { input: string; output: string; }
+  Map: { input: any; output: any; }
 };
 
 /** Lightweight status for tracking which beans have running agents */
@@ -126,8 +127,44 @@ export type AskUserQuestion = {
   question: Scalars['String']['output'];
 };
 
+/** A link operation in a batch. */
+export type BatchLink = {
+  /** ID of the bean to add the link to */
+  id: Scalars['ID']['input'];
+  /** ETag for optimistic concurrency control (optional) */
+  ifMatch?: InputMaybe<Scalars['String']['input']>;
+  /** ID of the linked bean */
+  targetId: Scalars['String']['input'];
+  /** Link type (relates_to, duplicates, caused_by, or a custom link type) */
+  type: Scalars['String']['input'];
+};
+
+/** One operation in a batch. Exactly one of create, update, addLink or delete must be set. */
+export type BatchOperation = {
+  /** Add a typed link between two beans */
+  addLink?: InputMaybe<BatchLink>;
+  /** Create a bean */
+  create?: InputMaybe<CreateBeanInput>;
+  /** Delete a bean by ID (automatically removes incoming links) */
+  delete?: InputMaybe<Scalars['ID']['input']>;
+  /** Name for the bean created by this operation; later operations can use $name wherever a bean ID is expected */
+  ref?: InputMaybe<Scalars['String']['input']>;
+  /** Update a bean */
+  update?: InputMaybe<BatchUpdate>;
+};
+
+/** An update operation in a batch. */
+export type BatchUpdate = {
+  /** ID of the bean to update */
+  id: Scalars['ID']['input'];
+  /** Changes to apply */
+  input: UpdateBeanInput;
+};
+
 /** A bean represents an issue/task in the beans tracker */
 export type Bean = {
+  /** Users assigned to this bean (names or emails) */
+  assignees: Array<Scalars['String']['output']>;
   /** Beans that block this one (incoming blocking links) */
   blockedBy: Array<Bean>;
   /** IDs of beans that are blocking this bean (direct field) */
@@ -138,12 +175,32 @@ export type Bean = {
   blockingIds: Array<Scalars['String']['output']>;
   /** Markdown body content */
   body: Scalars['String']['output'];
+  /** Beans that caused this one */
+  causedBy: Array<Bean>;
+  /** Beans caused by this one (inverse of caused_by) */
+  causes: Array<Bean>;
   /** Child beans (beans with this as parent) */
   children: Array<Bean>;
+  /** Comment thread, oldest first (kept separate from the body) */
+  comments: Array<Comment>;
+  /** Sum of the estimates of descendants with a completed (archive) status */
+  completedEstimate: Scalars['Float']['output'];
   /** Creation timestamp */
   createdAt: Scalars['Time']['output'];
+  /** When this bean is due (date-only values are midnight UTC) */
+  dueAt?: Maybe<Scalars['Time']['output']>;
+  /** Beans that duplicate this one (inverse of duplicates) */
+  duplicatedBy: Array<Bean>;
+  /** Beans this one duplicates */
+  duplicates: Array<Bean>;
+  /** Estimated effort in the configured unit (points by default), 0 if not estimated */
+  estimate: Scalars['Float']['output'];
   /** Content hash for optimistic concurrency control */
   etag: Scalars['String']['output'];
+  /** Custom front matter fields as a name to value map (declared in .beans.yml) */
+  fields: Scalars['Map']['output'];
+  /** Committed changes to this bean from git, newest first (empty if the project is not in git) */
+  history: Array<BeanHistoryEntry>;
   /** Unique identifier (NanoID) */
   id: Scalars['ID']['output'];
   /** Terminal status (scrapped or completed) inherited from the nearest terminal ancestor, if any */
@@ -152,6 +209,14 @@ export type Bean = {
   implicitStatusFrom?: Maybe<Scalars['String']['output']>;
   /** Whether this bean has unsaved runtime changes (not yet persisted to disk) */
   isDirty: Scalars['Boolean']['output'];
+  /** Whether the due date has passed and the bean is not completed or scrapped */
+  isOverdue: Scalars['Boolean']['output'];
+  /** Number of the forge issue the bean is synced with (0 if not synced) */
+  issue: Scalars['Int']['output'];
+  /** Beans linked by any link type, including custom ones. With inverse set, returns the beans linking to this one instead. */
+  linked: Array<Bean>;
+  /** Typed links to other beans (relates_to, duplicates, caused_by, or custom link types from .beans.yml) */
+  links: Array<BeanLink>;
   /** Fractional index for manual ordering within status groups */
   order: Scalars['String']['output'];
   /** Parent bean (resolved from parentId) */
@@ -162,14 +227,28 @@ export type Bean = {
   path: Scalars['String']['output'];
   /** Priority level (critical, high, normal, low, deferred) */
   priority: Scalars['String']['output'];
+  /** Related beans, in either direction (relates_to is symmetric) */
+  relatesTo: Array<Bean>;
+  /**
+   * Beans with the most similar title, tags and body, best first, including
+   * archived ones. Finds likely duplicates even when they share few words
+   * (e.g. "login broken" and "auth failure").
+   */
+  similar: Array<SimilarBean>;
   /** Human-readable slug from filename */
   slug?: Maybe<Scalars['String']['output']>;
+  /** Where the bean was imported from, such as a GitHub issue URL (empty if not imported) */
+  source: Scalars['String']['output'];
+  /** When work is planned to start (date-only values are midnight UTC) */
+  startAt?: Maybe<Scalars['Time']['output']>;
   /** Current status (draft, todo, in-progress, completed, scrapped) */
   status: Scalars['String']['output'];
   /** Tags for categorization */
   tags: Array<Scalars['String']['output']>;
   /** Bean title */
   title: Scalars['String']['output'];
+  /** Sum of the estimates of all descendants (scrapped beans are skipped) */
+  totalEstimate: Scalars['Float']['output'];
   /** Bean type (milestone, epic, bug, feature, task) */
   type: Scalars['String']['output'];
   /** Last update timestamp */
@@ -191,11 +270,55 @@ export type BeanBlockingArgs = {
 };
 
 
+/** A bean represents an issue/task in the beans tracker */
+export type BeanCausedByArgs = {
+  filter?: InputMaybe<BeanFilter>;
+};
+
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanCausesArgs = {
+  filter?: InputMaybe<BeanFilter>;
+};
+
+
 /** A bean represents an issue/task in the beans tracker */
 export type BeanChildrenArgs = {
   filter?: InputMaybe<BeanFilter>;
 };
 
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanDuplicatedByArgs = {
+  filter?: InputMaybe<BeanFilter>;
+};
+
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanDuplicatesArgs = {
+  filter?: InputMaybe<BeanFilter>;
+};
+
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanLinkedArgs = {
+  filter?: InputMaybe<BeanFilter>;
+  inverse?: InputMaybe<Scalars['Boolean']['input']>;
+  type: Scalars['String']['input'];
+};
+
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanRelatesToArgs = {
+  filter?: InputMaybe<BeanFilter>;
+};
+
+
+/** A bean represents an issue/task in the beans tracker */
+export type BeanSimilarArgs = {
+  limit?: InputMaybe<Scalars['Int']['input']>;
+};
+
 /** Represents a change to a bean */
 export type BeanChangeEvent = {
   /** The bean that changed (null for INITIAL_SNAPSHOT and DELETED events) */
@@ -210,10 +333,18 @@ export type BeanChangeEvent = {
 
 /** Filter options for querying beans */
 export type BeanFilter = {
+  /** Include only beans assigned to any of these users (OR logic) */
+  assignee?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Include only beans blocked by this specific bean ID (via blocked_by field) */
   blockedById?: InputMaybe<Scalars['String']['input']>;
   /** Include only beans that are blocking this specific bean ID */
   blockingId?: InputMaybe<Scalars['String']['input']>;
+  /** Include only beans due after this time */
+  dueAfter?: InputMaybe<Scalars['Time']['input']>;
+  /** Include only beans due before this time */
+  dueBefore?: InputMaybe<Scalars['Time']['input']>;
+  /** Exclude beans matching any of these custom field conditions */
+  excludeFields?: InputMaybe<Array<FieldFilter>>;
   /** Exclude beans that inherit a terminal status (scrapped or completed) from an ancestor */
   excludeImplicitTerminal?: InputMaybe<Scalars['Boolean']['input']>;
   /** Exclude beans with these priorities */
@@ -224,10 +355,14 @@ export type BeanFilter = {
   excludeTags?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Exclude beans with these types */
   excludeType?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Include only beans matching all of these custom field conditions */
+  fields?: InputMaybe<Array<FieldFilter>>;
   /** Include only beans that have explicit blocked-by entries */
   hasBlockedBy?: InputMaybe<Scalars['Boolean']['input']>;
   /** Include only beans that are blocking other beans */
   hasBlocking?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Include only beans with all of these typed links (either direction for symmetric link types) */
+  hasLinks?: InputMaybe<Array<LinkFilter>>;
   /** Include only beans with a parent */
   hasParent?: InputMaybe<Scalars['Boolean']['input']>;
   /** Include beans that are blocked — explicitly (direct blockers) or implicitly (ancestor is blocked) */
@@ -236,18 +371,24 @@ export type BeanFilter = {
   isExplicitlyBlocked?: InputMaybe<Scalars['Boolean']['input']>;
   /** Filter beans that are implicitly blocked (an ancestor in the parent chain is blocked) */
   isImplicitlyBlocked?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Include only beans that are the target of all of these typed links (id is the linking bean) */
+  linkedFrom?: InputMaybe<Array<LinkFilter>>;
   /** Exclude beans that have explicit blocked-by entries */
   noBlockedBy?: InputMaybe<Scalars['Boolean']['input']>;
   /** Exclude beans that are blocking other beans */
   noBlocking?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Exclude beans with typed links of any of these types */
+  noLinks?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Exclude beans that have a parent */
   noParent?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Include only beans whose due date has passed and that are not completed or scrapped */
+  overdue?: InputMaybe<Scalars['Boolean']['input']>;
   /** Include only beans with this specific parent ID */
   parentId?: InputMaybe<Scalars['String']['input']>;
   /** Include only beans with these priorities (OR logic) */
   priority?: InputMaybe<Array<Scalars['String']['input']>>;
   /**
-   * Full-text search across slug, title, and body using Bleve query syntax.
+   * Full-text search across slug, title, body and tags using Bleve query syntax.
    *
    * Examples:
    * - "login" - exact term match
@@ -260,6 +401,8 @@ export type BeanFilter = {
    * - "slug:auth" - search only slug field
    * - "title:login" - search only title field
    * - "body:auth" - search only body field
+   * - "tags:auth", "type:bug", "status:todo" - match a tag, type or status
+   * - "archived:true" - only archived beans
    */
   search?: InputMaybe<Scalars['String']['input']>;
   /** Include only beans with these statuses (OR logic) */
@@ -268,6 +411,36 @@ export type BeanFilter = {
   tags?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Include only beans with these types (OR logic) */
   type?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Include only beans without assignees */
+  unassigned?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Query expression, e.g. "type = bug AND priority >= high" (see beans list --help) */
+  where?: InputMaybe<Scalars['String']['input']>;
+};
+
+/** A commit that changed a bean file */
+export type BeanHistoryEntry = {
+  /** What the commit did: created, updated, archived, unarchived, moved or deleted */
+  action: Scalars['String']['output'];
+  /** Commit author name */
+  author: Scalars['String']['output'];
+  /** Commit author email */
+  authorEmail: Scalars['String']['output'];
+  /** Field changes compared to the previous commit */
+  changes: Array<FieldChange>;
+  /** Commit hash */
+  commit: Scalars['String']['output'];
+  /** Commit message subject */
+  message: Scalars['String']['output'];
+  /** Commit timestamp */
+  timestamp: Scalars['Time']['output'];
+};
+
+/** A typed link from a bean to another bean */
+export type BeanLink = {
+  /** ID of the linked bean */
+  targetId: Scalars['String']['output'];
+  /** Link type (relates_to, duplicates, caused_by, or a custom link type) */
+  type: Scalars['String']['output'];
 };
 
 /**
@@ -305,20 +478,42 @@ export enum ChangeType {
   Updated = 'UPDATED'
 }
 
+/** A comment in a bean's discussion thread */
+export type Comment = {
+  /** Who wrote the comment (empty if unknown) */
+  author: Scalars['String']['output'];
+  /** When the comment was posted */
+  createdAt: Scalars['Time']['output'];
+  /** Comment text (markdown) */
+  text: Scalars['String']['output'];
+};
+
 /** Input for creating a new bean */
 export type CreateBeanInput = {
+  /** Users to assign */
+  assignees?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Bean IDs that are blocking this bean */
   blockedBy?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Bean IDs this bean is blocking */
   blocking?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Markdown body content */
   body?: InputMaybe<Scalars['String']['input']>;
+  /** Due date (YYYY-MM-DD or RFC 3339) */
+  dueAt?: InputMaybe<Scalars['String']['input']>;
+  /** Estimated effort in the configured unit */
+  estimate?: InputMaybe<Scalars['Float']['input']>;
+  /** Custom field values (fields must be declared in .beans.yml) */
+  fields?: InputMaybe<Array<FieldInput>>;
+  /** Typed links to other beans (validates link type, existence and cycles) */
+  links?: InputMaybe<Array<LinkInput>>;
   /** Parent bean ID (validated against type hierarchy) */
   parent?: InputMaybe<Scalars['String']['input']>;
   /** Custom ID prefix (overrides config prefix for this bean) */
   prefix?: InputMaybe<Scalars['String']['input']>;
   /** Priority level (defaults to 'normal') */
   priority?: InputMaybe<Scalars['String']['input']>;
+  /** Start date (YYYY-MM-DD or RFC 3339) */
+  startAt?: InputMaybe<Scalars['String']['input']>;
   /** Status (defaults to 'todo') */
   status?: InputMaybe<Scalars['String']['input']>;
   /** Tags for categorization */
@@ -329,6 +524,36 @@ export type CreateBeanInput = {
   type?: InputMaybe<Scalars['String']['input']>;
 };
 
+/** A change to one front matter field, or to the body */
+export type FieldChange = {
+  /** Values added to a list field, or lines added to the body */
+  added: Array<Scalars['String']['output']>;
+  /** Field name (front matter key, custom field name, or 'body') */
+  field: Scalars['String']['output'];
+  /** Previous value of a scalar field (empty if unset) */
+  from: Scalars['String']['output'];
+  /** Values removed from a list field, or lines removed from the body */
+  removed: Array<Scalars['String']['output']>;
+  /** New value of a scalar field (empty if unset) */
+  to: Scalars['String']['output'];
+};
+
+/** A condition on a custom field */
+export type FieldFilter = {
+  /** Field name */
+  name: Scalars['String']['input'];
+  /** Match any of these values (OR logic). When omitted, matches beans that have the field set. */
+  values?: InputMaybe<Array<Scalars['String']['input']>>;
+};
+
+/** A custom field value to set on a bean. */
+export type FieldInput = {
+  /** Field name (declared in .beans.yml) */
+  name: Scalars['String']['input'];
+  /** Field value, validated against the field's type */
+  value: Scalars['String']['input'];
+};
+
 /** Input for attaching a file or directory as context to an agent message. */
 export type FileAttachmentInput = {
   /** Relative file or directory path */
@@ -370,22 +595,84 @@ export enum InteractionType {
   ExitPlan = 'EXIT_PLAN'
 }
 
+/** A condition on a typed link */
+export type LinkFilter = {
+  /** ID of the bean at the other end of the link (any bean if omitted) */
+  id?: InputMaybe<Scalars['String']['input']>;
+  /** Link type (relates_to, duplicates, caused_by, or a custom link type) */
+  type: Scalars['String']['input'];
+};
+
+/** A typed link to add to or remove from a bean. */
+export type LinkInput = {
+  /** ID of the linked bean */
+  targetId: Scalars['String']['input'];
+  /** Link type (relates_to, duplicates, caused_by, or a custom link type) */
+  type: Scalars['String']['input'];
+};
+
+/** Result of merging a duplicate bean into another */
+export type MergeBeanResult = {
+  /** The merged duplicate, now scrapped with a duplicates link to the target */
+  duplicate: Bean;
+  /** The bean the duplicate was merged into */
+  into: Bean;
+  /** Number of incoming links rewritten to point at the target */
+  linksRewritten: Scalars['Int']['output'];
+  /** Links dropped instead of rewritten because they would have created a cycle */
+  skipped: Array<Scalars['String']['output']>;
+};
+
+/** How a pull/merge request is merged into its base branch */
+export enum MergeMethod {
+  /** Create a merge commit */
+  Merge = 'MERGE',
+  /** Rebase the commits onto the base branch */
+  Rebase = 'REBASE',
+  /** Squash all commits into one */
+  Squash = 'SQUASH'
+}
+
 export type Mutation = {
   /** Add a bean to the blocked-by list (this bean is blocked by targetId) */
   addBlockedBy: Bean;
   /** Add a bean to the blocking list */
   addBlocking: Bean;
+  /**
+   * Add a comment to a bean's discussion thread. The author defaults to the
+   * current user (see currentUser).
+   */
+  addComment: Bean;
+  /** Add a typed link from a bean to another bean (validates link type, existence and cycles) */
+  addLink: Bean;
   /**
    * Archive a bean by moving it to the archive directory.
    * Only beans with archive-eligible statuses (completed, scrapped) can be archived.
    */
   archiveBean: Scalars['Boolean']['output'];
+  /**
+   * Apply several operations as a single transaction. Operations run in order
+   * against a staged copy of the graph, so later operations (and their link
+   * validation) see the beans created and changed by earlier ones. If any
+   * operation fails, nothing is written; otherwise all files are written
+   * together. Returns one entry per operation (null for deletes).
+   */
+  batch: Array<Maybe<Bean>>;
   /**
    * Clear the agent session for a bean. Stops any running process, removes the
    * session from memory, and deletes persisted conversation history.
    */
   clearAgentSession: Scalars['Boolean']['output'];
-  /** Create a new bean */
+  /** Close the open pull/merge request of a workspace's branch without merging it. */
+  closePullRequest: Scalars['Boolean']['output'];
+  /**
+   * Create a new bean.
+   *
+   * If search.duplicate_threshold is set in .beans.yml, existing beans (open or
+   * archived) similar to the new one are reported in the "duplicates" response
+   * extension, keyed by the new bean's ID:
+   * { "duplicates": { "<id>": [{ "id", "title", "status", "score" }] } }
+   */
   createBean: Bean;
   /** Create a new worktree. Returns the created worktree with a generated ID. */
   createWorktree: Worktree;
@@ -400,9 +687,23 @@ export type Mutation = {
   discardFileChange: Scalars['Boolean']['output'];
   /**
    * Execute a predefined agent action (e.g., "commit", "review") by injecting
-   * the corresponding prompt into the agent conversation.
+   * the corresponding prompt into the agent conversation. The PR action merges
+   * a mergeable pull request with nothing left to push directly, without the agent.
    */
   executeAgentAction: Scalars['Boolean']['output'];
+  /**
+   * Merge a duplicate bean into another. Moves the duplicate's tags and blocking
+   * relationships onto the target, appends its body below a provenance note,
+   * rewrites incoming links to the target, and marks the duplicate scrapped with
+   * a duplicates link.
+   */
+  mergeBean: MergeBeanResult;
+  /**
+   * Merge the open pull/merge request of a workspace's branch on the git forge.
+   * Without a method, the repository's preferred merge method is used.
+   * Returns the merged pull request (null if the forge doesn't report it yet).
+   */
+  mergePullRequest: Maybe<PullRequest>;
   /**
    * Open a workspace directory in VS Code. For the main workspace, opens the
    * project root. For worktrees, opens the worktree directory.
@@ -412,8 +713,20 @@ export type Mutation = {
   removeBlockedBy: Bean;
   /** Remove a bean from the blocking list */
   removeBlocking: Bean;
+  /** Remove a typed link from a bean */
+  removeLink: Bean;
   /** Remove a worktree by its ID (works for both bean-attached and standalone worktrees). */
   removeWorktree: Scalars['Boolean']['output'];
+  /**
+   * Ask forge users (by username) to review the open pull/merge request of a
+   * workspace's branch. Returns the updated pull request.
+   */
+  requestPullRequestReview: Maybe<PullRequest>;
+  /**
+   * Re-run the failed CI checks of the open pull/merge request of a workspace's
+   * branch. Returns the updated pull request.
+   */
+  rerunFailedChecks: Maybe<PullRequest>;
   /** Save a specific bean to disk (must be dirty). Returns true if saved. */
   saveBean: Scalars['Boolean']['output'];
   /** Save all dirty beans to disk. Returns the number of beans saved. */
@@ -459,6 +772,12 @@ export type Mutation = {
   stopRun: Scalars['Boolean']['output'];
   /** Update an existing bean */
   updateBean: Bean;
+  /**
+   * Apply one update to every bean matching the filter, as a single transaction:
+   * if any bean fails validation or cannot be written, no bean is changed.
+   * With dryRun, returns the updated beans without writing them.
+   */
+  updateBeans: Array<Bean>;
   /**
    * Write input data to an existing terminal session's PTY.
    * Creates the session if it doesn't exist yet.
@@ -481,16 +800,42 @@ export type MutationAddBlockingArgs = {
 };
 
 
+export type MutationAddCommentArgs = {
+  author?: InputMaybe<Scalars['String']['input']>;
+  id: Scalars['ID']['input'];
+  ifMatch?: InputMaybe<Scalars['String']['input']>;
+  text: Scalars['String']['input'];
+};
+
+
+export type MutationAddLinkArgs = {
+  id: Scalars['ID']['input'];
+  ifMatch?: InputMaybe<Scalars['String']['input']>;
+  targetId: Scalars['ID']['input'];
+  type: Scalars['String']['input'];
+};
+
+
 export type MutationArchiveBeanArgs = {
   id: Scalars['ID']['input'];
 };
 
 
+export type MutationBatchArgs = {
+  operations: Array<BatchOperation>;
+};
+
+
 export type MutationClearAgentSessionArgs = {
   beanId: Scalars['ID']['input'];
 };
 
 
+export type MutationClosePullRequestArgs = {
+  workspaceId: Scalars['ID']['input'];
+};
+
+
 export type MutationCreateBeanArgs = {
   input: CreateBeanInput;
 };
@@ -519,6 +864,18 @@ export type MutationExecuteAgentActionArgs = {
 };
 
 
+export type MutationMergeBeanArgs = {
+  id: Scalars['ID']['input'];
+  into: Scalars['ID']['input'];
+};
+
+
+export type MutationMergePullRequestArgs = {
+  method?: InputMaybe<MergeMethod>;
+  workspaceId: Scalars['ID']['input'];
+};
+
+
 export type MutationOpenInEditorArgs = {
   workspaceId: Scalars['ID']['input'];
 };
@@ -538,11 +895,30 @@ export type MutationRemoveBlockingArgs = {
 };
 
 
+export type MutationRemoveLinkArgs = {
+  id: Scalars['ID']['input'];
+  ifMatch?: InputMaybe<Scalars['String']['input']>;
+  targetId: Scalars['ID']['input'];
+  type: Scalars['String']['input'];
+};
+
+
 export type MutationRemoveWorktreeArgs = {
   id: Scalars['ID']['input'];
 };
 
 
+export type MutationRequestPullRequestReviewArgs = {
+  reviewers: Array<Scalars['String']['input']>;
+  workspaceId: Scalars['ID']['input'];
+};
+
+
+export type MutationRerunFailedChecksArgs = {
+  workspaceId: Scalars['ID']['input'];
+};
+
+
 export type MutationSaveBeanArgs = {
   id: Scalars['ID']['input'];
 };
@@ -609,6 +985,13 @@ export type MutationUpdateBeanArgs = {
 };
 
 
+export type MutationUpdateBeansArgs = {
+  dryRun?: InputMaybe<Scalars['Boolean']['input']>;
+  filter: BeanFilter;
+  input: UpdateBeanInput;
+};
+
+
 export type MutationWriteTerminalInputArgs = {
   data: Scalars['String']['input'];
   sessionId: Scalars['String']['input'];
@@ -677,6 +1060,8 @@ export type Query = {
    * a rebase would conflict. If path is null, uses the project root.
    */
   branchStatus: BranchStatus;
+  /** The identity of the current user (BEANS_USER, beans.user in .beans.yml, or git user.email) */
+  currentUser: Scalars['String']['output'];
   /** Get file changes for a directory. If path is null, uses the project root. */
   fileChanges: Array<FileChange>;
   /**
@@ -702,6 +1087,15 @@ export type Query = {
    * Returns empty string if not configured.
    */
   projectName: Scalars['String']['output'];
+  /**
+   * Full-text search over all beans, archived ones included, best match first.
+   * Uses the same query syntax as BeanFilter.search. Besides slug, title and body,
+   * tags are searched, and fields can be targeted with e.g. "tags:auth",
+   * "type:bug", "status:completed" or "archived:true".
+   */
+  searchBeans: Array<SearchHit>;
+  /** Named views declared under views in .beans.yml */
+  views: Array<View>;
   /** Get the allocated port for a workspace. Returns 0 if not allocated. */
   workspacePort: Scalars['Int']['output'];
   /**
@@ -787,6 +1181,12 @@ export type QueryListFilesArgs = {
 };
 
 
+export type QuerySearchBeansArgs = {
+  limit?: InputMaybe<Scalars['Int']['input']>;
+  query: Scalars['String']['input'];
+};
+
+
 export type QueryWorkspacePortArgs = {
   workspaceId: Scalars['ID']['input'];
 };
@@ -799,6 +1199,32 @@ export type ReplaceOperation = {
   old: Scalars['String']['input'];
 };
 
+/** Snippets of one field that matched a search */
+export type SearchHighlight = {
+  /** Field name: slug, title, body or tags */
+  field: Scalars['String']['output'];
+  /** Snippets of the field, with matched terms wrapped in ** */
+  fragments: Array<Scalars['String']['output']>;
+};
+
+/** A bean matching a full-text search */
+export type SearchHit = {
+  /** The matching bean */
+  bean: Bean;
+  /** Matching snippets by field, with matched terms wrapped in ** (Markdown bold) */
+  highlights: Array<SearchHighlight>;
+  /** Relevance score (higher is better); only comparable within one search */
+  score: Scalars['Float']['output'];
+};
+
+/** A bean similar to another one */
+export type SimilarBean = {
+  /** The similar bean */
+  bean: Bean;
+  /** Cosine similarity of the beans' embeddings, up to 1 (identical) */
+  score: Scalars['Float']['output'];
+};
+
 /** Tracks real-time activity of a running subagent (Agent tool invocation) */
 export type SubagentActivity = {
   /** Tool currently being used by the subagent (empty string when idle) */
@@ -855,16 +1281,28 @@ export type SubscriptionBeanChangedArgs = {
 
 /** Input for updating an existing bean */
 export type UpdateBeanInput = {
+  /** Add assignees to existing list */
+  addAssignees?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Add beans to blocked-by list (validates cycles and existence) */
   addBlockedBy?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Add beans to blocking list (validates cycles and existence) */
   addBlocking?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Add typed links (validates link type, existence and cycles) */
+  addLinks?: InputMaybe<Array<LinkInput>>;
   /** Add tags to existing list */
   addTags?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Replace all assignees (nil preserves existing, mutually exclusive with addAssignees/removeAssignees) */
+  assignees?: InputMaybe<Array<Scalars['String']['input']>>;
   /** New body content (full replacement, mutually exclusive with bodyMod) */
   body?: InputMaybe<Scalars['String']['input']>;
   /** Structured body modifications (mutually exclusive with body) */
   bodyMod?: InputMaybe<BodyModification>;
+  /** Set due date (YYYY-MM-DD or RFC 3339, empty to clear) */
+  dueAt?: InputMaybe<Scalars['String']['input']>;
+  /** Set estimate (0 to clear) */
+  estimate?: InputMaybe<Scalars['Float']['input']>;
+  /** Set custom field values (an empty value removes the field) */
+  fields?: InputMaybe<Array<FieldInput>>;
   /** ETag for optimistic concurrency control (optional) */
   ifMatch?: InputMaybe<Scalars['String']['input']>;
   /** Fractional index for manual ordering (used by board drag-and-drop) */
@@ -873,12 +1311,18 @@ export type UpdateBeanInput = {
   parent?: InputMaybe<Scalars['String']['input']>;
   /** New priority */
   priority?: InputMaybe<Scalars['String']['input']>;
+  /** Remove assignees from existing list */
+  removeAssignees?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Remove beans from blocked-by list */
   removeBlockedBy?: InputMaybe<Array<Scalars['String']['input']>>;
   /** Remove beans from blocking list */
   removeBlocking?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Remove typed links */
+  removeLinks?: InputMaybe<Array<LinkInput>>;
   /** Remove tags from existing list */
   removeTags?: InputMaybe<Array<Scalars['String']['input']>>;
+  /** Set start date (YYYY-MM-DD or RFC 3339, empty to clear) */
+  startAt?: InputMaybe<Scalars['String']['input']>;
   /** New status */
   status?: InputMaybe<Scalars['String']['input']>;
   /** Replace all tags (nil preserves existing, mutually exclusive with addTags/removeTags) */
@@ -889,6 +1333,22 @@ export type UpdateBeanInput = {
   type?: InputMaybe<Scalars['String']['input']>;
 };
 
+/** A named view from .beans.yml: a saved filter with a sort order and columns */
+export type View = {
+  /** Beans matching the view's filter, in its sort order */
+  beans: Array<Bean>;
+  /** Columns to show (built-in column names or custom fields); empty for the default layout */
+  columns: Array<Scalars['String']['output']>;
+  /** What the view is for (empty if not set) */
+  description: Scalars['String']['output'];
+  /** The view's filter, keyed by BeanFilter field names */
+  filter: Scalars['Map']['output'];
+  /** View name, as used by beans list --view */
+  name: Scalars['String']['output'];
+  /** Sort order: created, updated, due, status, priority, id, or empty for the default order */
+  sort: Scalars['String']['output'];
+};
+
 /** Git status for a workspace (main repo or worktree) */
 export type WorkspaceStatus = {
   /** Whether the workspace has uncommitted changes or untracked files */
@@ -936,7 +1396,7 @@ export enum WorktreeSetupStatus {
   Running = 'RUNNING'
 }
 
-export type BeanFieldsFragment = { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, createdAt: string, updatedAt: string, body: string, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null };
+export type BeanFieldsFragment = { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, assignees: Array<string>, createdAt: string, updatedAt: string, startAt?: string | null, dueAt?: string | null, isOverdue: boolean, estimate: number, body: string, comments: Array<{ author: string, createdAt: string, text: string }>, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null };
 
 export type WorktreeFieldsFragment = { id: string, name?: string | null, description?: string | null, branch: string, path: string, setupStatus?: WorktreeSetupStatus | null, setupError?: string | null, beans: Array<{ id: string }>, pullRequest?: { number: number, title: string, state: string, url: string, isDraft: boolean, checkStatus: string, reviewApproved: boolean, mergeable: boolean } | null };
 
@@ -951,7 +1411,7 @@ export type BeanChangedSubscriptionVariables = Exact<{
 }>;
 
 
-export type BeanChangedSubscription = { beanChanged: { type: ChangeType, beanId: string, bean?: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, createdAt: string, updatedAt: string, body: string, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } | null, beans?: Array<{ id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, createdAt: string, updatedAt: string, body: string, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null }> | null } };
+export type BeanChangedSubscription = { beanChanged: { type: ChangeType, beanId: string, bean?: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, assignees: Array<string>, createdAt: string, updatedAt: string, startAt?: string | null, dueAt?: string | null, isOverdue: boolean, estimate: number, body: string, comments: Array<{ author: string, createdAt: string, text: string }>, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } | null, beans?: Array<{ id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, assignees: Array<string>, createdAt: string, updatedAt: string, startAt?: string | null, dueAt?: string | null, isOverdue: boolean, estimate: number, body: string, comments: Array<{ author: string, createdAt: string, text: string }>, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null }> | null } };
 
 export type WorktreesChangedSubscriptionVariables = Exact<{ [key: string]: never; }>;
 
@@ -1036,7 +1496,7 @@ export type CreateBeanMutationVariables = Exact<{
 }>;
 
 
-export type CreateBeanMutation = { createBean: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, createdAt: string, updatedAt: string, body: string, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } };
+export type CreateBeanMutation = { createBean: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, assignees: Array<string>, createdAt: string, updatedAt: string, startAt?: string | null, dueAt?: string | null, isOverdue: boolean, estimate: number, body: string, comments: Array<{ author: string, createdAt: string, text: string }>, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } };
 
 export type UpdateBeanMutationVariables = Exact<{
   id: Scalars['ID']['input'];
@@ -1044,7 +1504,7 @@ export type UpdateBeanMutationVariables = Exact<{
 }>;
 
 
-export type UpdateBeanMutation = { updateBean: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, createdAt: string, updatedAt: string, body: string, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } };
+export type UpdateBeanMutation = { updateBean: { id: string, slug?: string | null, path: string, title: string, status: string, type: string, priority: string, tags: Array<string>, assignees: Array<string>, createdAt: string, updatedAt: string, startAt?: string | null, dueAt?: string | null, isOverdue: boolean, estimate: number, body: string, comments: Array<{ author: string, createdAt: string, text: string }>, order: string, parentId?: string | null, blockingIds: Array<string>, worktreeId?: string | null } };
 
 export type UpdateBeanStatusMutationVariables = Exact<{
   id: Scalars['ID']['input'];
@@ -1207,12 +1667,12 @@ export type WorkspacePortQueryVariables = Exact<{
 
 export type WorkspacePortQuery = { workspacePort: number };
 
-export const BeanFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<BeanFieldsFragment, unknown>;
+export const BeanFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"assignees"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"startAt"}},{"kind":"Field","name":{"kind":"Name","value":"dueAt"}},{"kind":"Field","name":{"kind":"Name","value":"isOverdue"}},{"kind":"Field","name":{"kind":"Name","value":"estimate"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"comments"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"author"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"text"}}]}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<BeanFieldsFragment, unknown>;
 export const WorktreeFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"WorktreeFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Worktree"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"name"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"branch"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"beans"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}}]}},{"kind":"Field","name":{"kind":"Name","value":"setupStatus"}},{"kind":"Field","name":{"kind":"Name","value":"setupError"}},{"kind":"Field","name":{"kind":"Name","value":"pullRequest"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"number"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"state"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"isDraft"}},{"kind":"Field","name":{"kind":"Name","value":"checkStatus"}},{"kind":"Field","name":{"kind":"Name","value":"reviewApproved"}},{"kind":"Field","name":{"kind":"Name","value":"mergeable"}}]}}]}}]} as unknown as DocumentNode<WorktreeFieldsFragment, unknown>;
 export const AgentSessionFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"AgentSessionFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"AgentSession"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"beanId"}},{"kind":"Field","name":{"kind":"Name","value":"agentType"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"messages"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"role"}},{"kind":"Field","name":{"kind":"Name","value":"content"}},{"kind":"Field","name":{"kind":"Name","value":"images"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"mediaType"}}]}},{"kind":"Field","name":{"kind":"Name","value":"attachments"}},{"kind":"Field","name":{"kind":"Name","value":"diff"}}]}},{"kind":"Field","name":{"kind":"Name","value":"error"}},{"kind":"Field","name":{"kind":"Name","value":"effort"}},{"kind":"Field","name":{"kind":"Name","value":"planMode"}},{"kind":"Field","name":{"kind":"Name","value":"actMode"}},{"kind":"Field","name":{"kind":"Name","value":"systemStatus"}},{"kind":"Field","name":{"kind":"Name","value":"pendingInteraction"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"planContent"}},{"kind":"Field","name":{"kind":"Name","value":"questions"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"header"}},{"kind":"Field","name":{"kind":"Name","value":"question"}},{"kind":"Field","name":{"kind":"Name","value":"multiSelect"}},{"kind":"Field","name":{"kind":"Name","value":"options"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"label"}},{"kind":"Field","name":{"kind":"Name","value":"description"}}]}}]}}]}},{"kind":"Field","name":{"kind":"Name","value":"workDir"}},{"kind":"Field","name":{"kind":"Name","value":"subagentActivities"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"taskId"}},{"kind":"Field","name":{"kind":"Name","value":"index"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"currentTool"}}]}},{"kind":"Field","name":{"kind":"Name","value":"quickReplies"}}]}}]} as unknown as DocumentNode<AgentSessionFieldsFragment, unknown>;
 export const FileChangeFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"FileChangeFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"FileChange"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"additions"}},{"kind":"Field","name":{"kind":"Name","value":"deletions"}},{"kind":"Field","name":{"kind":"Name","value":"staged"}}]}}]} as unknown as DocumentNode<FileChangeFieldsFragment, unknown>;
 export const AgentActionFieldsFragmentDoc = {"kind":"Document","definitions":[{"kind":"FragmentDefinition","name":{"kind":"Name","value":"AgentActionFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"AgentAction"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"label"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"disabled"}},{"kind":"Field","name":{"kind":"Name","value":"disabledReason"}}]}}]} as unknown as DocumentNode<AgentActionFieldsFragment, unknown>;
-export const BeanChangedDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"subscription","name":{"kind":"Name","value":"BeanChanged"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"includeInitial"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"Boolean"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"beanChanged"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"includeInitial"},"value":{"kind":"Variable","name":{"kind":"Name","value":"includeInitial"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"beanId"}},{"kind":"Field","name":{"kind":"Name","value":"bean"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}},{"kind":"Field","name":{"kind":"Name","value":"beans"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<BeanChangedSubscription, BeanChangedSubscriptionVariables>;
+export const BeanChangedDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"subscription","name":{"kind":"Name","value":"BeanChanged"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"includeInitial"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"Boolean"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"beanChanged"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"includeInitial"},"value":{"kind":"Variable","name":{"kind":"Name","value":"includeInitial"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"beanId"}},{"kind":"Field","name":{"kind":"Name","value":"bean"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}},{"kind":"Field","name":{"kind":"Name","value":"beans"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"assignees"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"startAt"}},{"kind":"Field","name":{"kind":"Name","value":"dueAt"}},{"kind":"Field","name":{"kind":"Name","value":"isOverdue"}},{"kind":"Field","name":{"kind":"Name","value":"estimate"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"comments"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"author"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"text"}}]}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<BeanChangedSubscription, BeanChangedSubscriptionVariables>;
 export const WorktreesChangedDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"subscription","name":{"kind":"Name","value":"WorktreesChanged"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"worktreesChanged"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"WorktreeFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"WorktreeFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Worktree"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"name"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"branch"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"beans"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}}]}},{"kind":"Field","name":{"kind":"Name","value":"setupStatus"}},{"kind":"Field","name":{"kind":"Name","value":"setupError"}},{"kind":"Field","name":{"kind":"Name","value":"pullRequest"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"number"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"state"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"isDraft"}},{"kind":"Field","name":{"kind":"Name","value":"checkStatus"}},{"kind":"Field","name":{"kind":"Name","value":"reviewApproved"}},{"kind":"Field","name":{"kind":"Name","value":"mergeable"}}]}}]}}]} as unknown as DocumentNode<WorktreesChangedSubscription, WorktreesChangedSubscriptionVariables>;
 export const AgentSessionChangedDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"subscription","name":{"kind":"Name","value":"AgentSessionChanged"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"beanId"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"agentSessionChanged"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"beanId"},"value":{"kind":"Variable","name":{"kind":"Name","value":"beanId"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"AgentSessionFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"AgentSessionFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"AgentSession"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"beanId"}},{"kind":"Field","name":{"kind":"Name","value":"agentType"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"messages"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"role"}},{"kind":"Field","name":{"kind":"Name","value":"content"}},{"kind":"Field","name":{"kind":"Name","value":"images"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"mediaType"}}]}},{"kind":"Field","name":{"kind":"Name","value":"attachments"}},{"kind":"Field","name":{"kind":"Name","value":"diff"}}]}},{"kind":"Field","name":{"kind":"Name","value":"error"}},{"kind":"Field","name":{"kind":"Name","value":"effort"}},{"kind":"Field","name":{"kind":"Name","value":"planMode"}},{"kind":"Field","name":{"kind":"Name","value":"actMode"}},{"kind":"Field","name":{"kind":"Name","value":"systemStatus"}},{"kind":"Field","name":{"kind":"Name","value":"pendingInteraction"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"planContent"}},{"kind":"Field","name":{"kind":"Name","value":"questions"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"header"}},{"kind":"Field","name":{"kind":"Name","value":"question"}},{"kind":"Field","name":{"kind":"Name","value":"multiSelect"}},{"kind":"Field","name":{"kind":"Name","value":"options"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"label"}},{"kind":"Field","name":{"kind":"Name","value":"description"}}]}}]}}]}},{"kind":"Field","name":{"kind":"Name","value":"workDir"}},{"kind":"Field","name":{"kind":"Name","value":"subagentActivities"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"taskId"}},{"kind":"Field","name":{"kind":"Name","value":"index"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"currentTool"}}]}},{"kind":"Field","name":{"kind":"Name","value":"quickReplies"}}]}}]} as unknown as DocumentNode<AgentSessionChangedSubscription, AgentSessionChangedSubscriptionVariables>;
 export const ActiveAgentStatusesDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"subscription","name":{"kind":"Name","value":"ActiveAgentStatuses"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"activeAgentStatuses"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"beanId"}},{"kind":"Field","name":{"kind":"Name","value":"status"}}]}}]}}]} as unknown as DocumentNode<ActiveAgentStatusesSubscription, ActiveAgentStatusesSubscriptionVariables>;
@@ -1225,8 +1685,8 @@ export const BranchStatusDocument = {"kind":"Document","definitions":[{"kind":"O
 export const AgentActionsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"AgentActions"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"beanId"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"skipForge"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"Boolean"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"agentActions"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"beanId"},"value":{"kind":"Variable","name":{"kind":"Name","value":"beanId"}}},{"kind":"Argument","name":{"kind":"Name","value":"skipForge"},"value":{"kind":"Variable","name":{"kind":"Name","value":"skipForge"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"AgentActionFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"AgentActionFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"AgentAction"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"label"}},{"kind":"Field","name":{"kind":"Name","value":"description"}},{"kind":"Field","name":{"kind":"Name","value":"disabled"}},{"kind":"Field","name":{"kind":"Name","value":"disabledReason"}}]}}]} as unknown as DocumentNode<AgentActionsQuery, AgentActionsQueryVariables>;
 export const FileDiffDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"FileDiff"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"filePath"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"staged"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"Boolean"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"path"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"fileDiff"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"filePath"},"value":{"kind":"Variable","name":{"kind":"Name","value":"filePath"}}},{"kind":"Argument","name":{"kind":"Name","value":"staged"},"value":{"kind":"Variable","name":{"kind":"Name","value":"staged"}}},{"kind":"Argument","name":{"kind":"Name","value":"path"},"value":{"kind":"Variable","name":{"kind":"Name","value":"path"}}}]}]}}]} as unknown as DocumentNode<FileDiffQuery, FileDiffQueryVariables>;
 export const AllFileDiffDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"AllFileDiff"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"filePath"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"path"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"allFileDiff"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"filePath"},"value":{"kind":"Variable","name":{"kind":"Name","value":"filePath"}}},{"kind":"Argument","name":{"kind":"Name","value":"path"},"value":{"kind":"Variable","name":{"kind":"Name","value":"path"}}}]}]}}]} as unknown as DocumentNode<AllFileDiffQuery, AllFileDiffQueryVariables>;
-export const CreateBeanDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"CreateBean"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"CreateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"createBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<CreateBeanMutation, CreateBeanMutationVariables>;
-export const UpdateBeanDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"UpdateBean"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"UpdateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"updateBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}},{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<UpdateBeanMutation, UpdateBeanMutationVariables>;
+export const CreateBeanDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"CreateBean"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"CreateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"createBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"assignees"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"startAt"}},{"kind":"Field","name":{"kind":"Name","value":"dueAt"}},{"kind":"Field","name":{"kind":"Name","value":"isOverdue"}},{"kind":"Field","name":{"kind":"Name","value":"estimate"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"comments"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"author"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"text"}}]}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<CreateBeanMutation, CreateBeanMutationVariables>;
+export const UpdateBeanDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"UpdateBean"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"UpdateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"updateBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}},{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"FragmentSpread","name":{"kind":"Name","value":"BeanFields"}}]}}]}},{"kind":"FragmentDefinition","name":{"kind":"Name","value":"BeanFields"},"typeCondition":{"kind":"NamedType","name":{"kind":"Name","value":"Bean"}},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"slug"}},{"kind":"Field","name":{"kind":"Name","value":"path"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"type"}},{"kind":"Field","name":{"kind":"Name","value":"priority"}},{"kind":"Field","name":{"kind":"Name","value":"tags"}},{"kind":"Field","name":{"kind":"Name","value":"assignees"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"updatedAt"}},{"kind":"Field","name":{"kind":"Name","value":"startAt"}},{"kind":"Field","name":{"kind":"Name","value":"dueAt"}},{"kind":"Field","name":{"kind":"Name","value":"isOverdue"}},{"kind":"Field","name":{"kind":"Name","value":"estimate"}},{"kind":"Field","name":{"kind":"Name","value":"body"}},{"kind":"Field","name":{"kind":"Name","value":"comments"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"author"}},{"kind":"Field","name":{"kind":"Name","value":"createdAt"}},{"kind":"Field","name":{"kind":"Name","value":"text"}}]}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}},{"kind":"Field","name":{"kind":"Name","value":"blockingIds"}},{"kind":"Field","name":{"kind":"Name","value":"worktreeId"}}]}}]} as unknown as DocumentNode<UpdateBeanMutation, UpdateBeanMutationVariables>;
 export const UpdateBeanStatusDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"UpdateBeanStatus"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"UpdateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"updateBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}},{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"status"}}]}}]}}]} as unknown as DocumentNode<UpdateBeanStatusMutation, UpdateBeanStatusMutationVariables>;
 export const UpdateBeanOrderDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"UpdateBeanOrder"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"UpdateBeanInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"updateBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}},{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"order"}},{"kind":"Field","name":{"kind":"Name","value":"parentId"}}]}}]}}]} as unknown as DocumentNode<UpdateBeanOrderMutation, UpdateBeanOrderMutationVariables>;
 export const DeleteBeanDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"DeleteBean"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"deleteBean"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}]}]}}]} as unknown as DocumentNode<DeleteBeanMutation, DeleteBeanMutationVariables>;
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/internal/ui"
 )
@@ -27,13 +28,15 @@ var checkCmd = &cobra.Command{
 	Use:   "check",
 	Short: "Validate configuration and bean integrity",
 	Long: `Checks configuration and bean integrity, including:
-- Configuration settings (colors, default type)
+- Configuration settings (colors, default type, custom types, fields and link types)
 - Broken links (links to non-existent beans)
 - Self-references (beans linking to themselves)
-- Circular dependencies (cycles in blocks/parent relationships)
+- Circular dependencies (cycles in blocks/parent relationships and acyclic
+  link types such as duplicates and caused_by)
+- Parent links that violate the configured parent type rules
 
 Use --fix to automatically remove broken links and self-references.
-Note: Cycles cannot be auto-fixed and require manual intervention.`,
+Note: Cycles and invalid parent types cannot be auto-fixed and require manual intervention.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var configErrors []string
 		var fixed int
@@ -43,17 +46,35 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			fmt.Println(ui.Bold.Render("Configuration"))
 		}
 
-		// 1. Check statuses are defined (always true since hardcoded)
+		// 1. Check statuses are defined
 		if !checkJSON {
-			fmt.Printf("  %s Statuses defined (%d hardcoded)\n", ui.Success.Render("✓"), len(config.DefaultStatuses))
+			added, overridden := 0, 0
+			for _, s := range cfg.CustomStatuses {
+				if config.IsBuiltinStatus(s.Name) {
+					overridden++
+				} else {
+					added++
+				}
+			}
+			fmt.Printf("  %s Statuses defined (%d built-in, %d added, %d overridden)\n", ui.Success.Render("✓"),
+				len(config.DefaultStatuses), added, overridden)
 		}
 
-		// 2. Check default_status exists in statuses (always true since hardcoded)
-		if !checkJSON {
+		// 2. Check default_status exists in statuses
+		if !cfg.IsValidStatus(cfg.GetDefaultStatus()) {
+			configErrors = append(configErrors, fmt.Sprintf("default_status '%s' is not a valid status", cfg.GetDefaultStatus()))
+		} else if !checkJSON {
 			fmt.Printf("  %s Default status '%s' exists\n", ui.Success.Render("✓"), cfg.GetDefaultStatus())
 		}
 
-		// 2b. Check default_type is a valid hardcoded type
+		// 2a. Check custom statuses and transitions reference known statuses
+		workflowErrors := cfg.ValidateWorkflow()
+		configErrors = append(configErrors, workflowErrors...)
+		if len(workflowErrors) == 0 && len(cfg.Transitions) > 0 && !checkJSON {
+			fmt.Printf("  %s Status transitions valid\n", ui.Success.Render("✓"))
+		}
+
+		// 2b. Check default_type is a valid type
 		if cfg.GetDefaultType() != "" && !cfg.IsValidType(cfg.GetDefaultType()) {
 			configErrors = append(configErrors, fmt.Sprintf("default_type '%s' is not a valid type", cfg.GetDefaultType()))
 		} else if cfg.GetDefaultType() != "" {
@@ -71,8 +92,8 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
-		// 3. Check all status colors are valid (hardcoded statuses)
-		for _, s := range config.DefaultStatuses {
+		// 3. Check all status colors are valid
+		for _, s := range cfg.Statuses() {
 			if !ui.IsValidColor(s.Color) {
 				configErrors = append(configErrors, fmt.Sprintf("invalid color '%s' for status '%s'", s.Color, s.Name))
 			}
@@ -89,8 +110,8 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
-		// 4. Check all type colors are valid (hardcoded types)
-		for _, t := range config.DefaultTypes {
+		// 4. Check all type colors are valid
+		for _, t := range cfg.Types() {
 			if !ui.IsValidColor(t.Color) {
 				configErrors = append(configErrors, fmt.Sprintf("invalid color '%s' for type '%s'", t.Color, t.Name))
 			}
@@ -107,6 +128,40 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
+		// 5. Check custom types and their parent rules
+		typeErrors := cfg.ValidateTypes()
+		configErrors = append(configErrors, typeErrors...)
+		if len(typeErrors) == 0 && len(cfg.CustomTypes) > 0 && !checkJSON {
+			fmt.Printf("  %s Custom types valid (%d defined)\n", ui.Success.Render("✓"), len(cfg.CustomTypes))
+		}
+
+		// 6. Check custom field declarations
+		fieldErrors := cfg.ValidateFields()
+		configErrors = append(configErrors, fieldErrors...)
+		if len(fieldErrors) == 0 && len(cfg.Fields) > 0 && !checkJSON {
+			fmt.Printf("  %s Custom fields valid (%d defined)\n", ui.Success.Render("✓"), len(cfg.Fields))
+		}
+
+		// 7. Check custom link type declarations
+		linkErrors := cfg.ValidateLinkTypes()
+		configErrors = append(configErrors, linkErrors...)
+		if len(linkErrors) == 0 && len(cfg.CustomLinkTypes) > 0 && !checkJSON {
+			fmt.Printf("  %s Custom link types valid (%d defined)\n", ui.Success.Render("✓"), len(cfg.CustomLinkTypes))
+		}
+
+		// 8. Check named views, including their filters
+		viewErrors := cfg.ValidateViews()
+		resolver := &beangraph.CoreResolver{Core: core}
+		for i := range cfg.Views {
+			if _, err := resolver.ViewFilter(&cfg.Views[i]); err != nil {
+				viewErrors = append(viewErrors, err.Error())
+			}
+		}
+		configErrors = append(configErrors, viewErrors...)
+		if len(viewErrors) == 0 && len(cfg.Views) > 0 && !checkJSON {
+			fmt.Printf("  %s Views valid (%d defined)\n", ui.Success.Render("✓"), len(cfg.Views))
+		}
+
 		// Print config errors in human-readable mode
 		if !checkJSON {
 			for _, e := range configErrors {
@@ -152,6 +207,13 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
+		// Parent type violations cannot be auto-fixed
+		if !checkJSON {
+			for _, ip := range linkResult.InvalidParents {
+				fmt.Printf("  %s %s: %s beans cannot have %s %s as parent\n", ui.Danger.Render("✗"), ip.BeanID, ip.BeanType, ip.ParentType, ip.Parent)
+			}
+		}
+
 		// Cycles cannot be auto-fixed
 		if !checkJSON {
 			for _, c := range linkResult.Cycles {
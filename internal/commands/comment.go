@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commentAuthor  string
+	commentIfMatch string
+	commentJSON    bool
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment <id> [text]",
+	Short: "Add a comment to a bean, or list its comments",
+	Long: `Adds a comment to a bean's discussion thread. Comments are stored in the bean
+file separately from the body, with the author and a timestamp, so discussion
+doesn't clutter the description. Use '-' as the text to read it from stdin.
+
+The author defaults to the current user (BEANS_USER, beans.user in .beans.yml,
+or git user.email); override it with --author.
+
+Without text, lists the bean's comments.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &beangraph.CoreResolver{Core: core}
+
+		b, err := resolver.Bean(ctx, args[0])
+		if err != nil || b == nil {
+			return cmdError(commentJSON, output.ErrNotFound, "bean not found: %s", args[0])
+		}
+
+		if len(args) == 1 {
+			if commentJSON {
+				return output.SuccessSingle(b)
+			}
+			if len(b.Comments) == 0 {
+				fmt.Println(ui.Muted.Render("No comments yet."))
+				return nil
+			}
+			fmt.Print(formatComments(b.Comments))
+			return nil
+		}
+
+		text, err := resolveAppendContent(args[1])
+		if err != nil {
+			return cmdError(commentJSON, output.ErrFileError, "%s", err)
+		}
+
+		var author, ifMatch *string
+		if cmd.Flags().Changed("author") {
+			author = &commentAuthor
+		}
+		if commentIfMatch != "" {
+			ifMatch = &commentIfMatch
+		}
+
+		b, err = resolver.AddComment(ctx, b.ID, text, author, ifMatch)
+		if err != nil {
+			return mutationError(commentJSON, err)
+		}
+
+		if commentJSON {
+			return output.Success(b, "Comment added")
+		}
+		fmt.Println(ui.Success.Render("Commented on ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
+		return nil
+	},
+}
+
+// formatComments renders a comment thread, one header line per comment
+// followed by its indented text.
+func formatComments(comments []bean.Comment) string {
+	var sb strings.Builder
+	for i, c := range comments {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		author := c.Author
+		if author == "" {
+			author = "unknown"
+		}
+		sb.WriteString(ui.Bold.Render(author))
+		sb.WriteString("  ")
+		sb.WriteString(ui.Muted.Render(c.CreatedAt.Format("2006-01-02 15:04 UTC")))
+		sb.WriteString("\n")
+		for _, line := range strings.Split(c.Text, "\n") {
+			sb.WriteString("  " + line + "\n")
+		}
+	}
+	return sb.String()
+}
+
+func RegisterCommentCmd(root *cobra.Command) {
+	commentCmd.Flags().StringVar(&commentAuthor, "author", "", "Comment author (defaults to the current user)")
+	commentCmd.Flags().StringVar(&commentIfMatch, "if-match", "", "Only update if etag matches (optimistic locking)")
+	commentCmd.Flags().BoolVar(&commentJSON, "json", false, "Output as JSON")
+	root.AddCommand(commentCmd)
+}
@@ -1,16 +1,20 @@
 package commands
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/config"
 	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/internal/output"
 	"github.com/hmans/beans/internal/ui"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var (
@@ -23,7 +27,14 @@ var (
 	createParent    string
 	createBlocking  []string
 	createBlockedBy []string
+	createLink      []string
 	createPrefix    string
+	createField     []string
+	createAssignee  []string
+	createStart     string
+	createDue       string
+	createEstimate  float64
+	createStrict    bool
 	createJSON      bool
 )
 
@@ -31,7 +42,13 @@ var createCmd = &cobra.Command{
 	Use:     "create [title]",
 	Aliases: []string{"c", "new"},
 	Short:   "Create a new bean",
-	Long:    `Creates a new bean (issue) with a generated ID and optional title.`,
+	Long: `Creates a new bean (issue) with a generated ID and optional title.
+
+If search.duplicate_threshold is set in .beans.yml, existing beans (open or
+archived) with a similar title, tags and body are looked up first. When there
+are any, you are asked whether to create the bean anyway; without a terminal
+(or with --json) the bean is created with a warning. Use --strict to fail
+instead, which also checks with a threshold of 0.8 if none is configured.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		title := strings.Join(args, " ")
 		if title == "" {
@@ -49,6 +66,28 @@ var createCmd = &cobra.Command{
 			return cmdError(createJSON, output.ErrValidation, "invalid priority: %s (must be %s)", createPriority, cfg.PriorityList())
 		}
 
+		for _, date := range []string{createStart, createDue} {
+			if date == "" {
+				continue
+			}
+			if _, err := bean.ParseDate(date); err != nil {
+				return cmdError(createJSON, output.ErrValidation, "%s", err)
+			}
+		}
+
+		fields, err := parseFieldFlags(createField)
+		if err != nil {
+			return cmdError(createJSON, output.ErrValidation, "%s", err)
+		}
+		assignees, err := resolveAssignees(createAssignee)
+		if err != nil {
+			return cmdError(createJSON, output.ErrValidation, "%s", err)
+		}
+		links, err := parseLinkFlags(createLink)
+		if err != nil {
+			return cmdError(createJSON, output.ErrValidation, "%s", err)
+		}
+
 		body, err := resolveContent(createBody, createBodyFile)
 		if err != nil {
 			return cmdError(createJSON, output.ErrFileError, "%s", err)
@@ -77,6 +116,17 @@ var createCmd = &cobra.Command{
 		if len(createTag) > 0 {
 			input.Tags = createTag
 		}
+		input.Fields = fields
+		input.Assignees = assignees
+		if createStart != "" {
+			input.StartAt = &createStart
+		}
+		if createDue != "" {
+			input.DueAt = &createDue
+		}
+		if createEstimate != 0 {
+			input.Estimate = &createEstimate
+		}
 
 		// Add parent
 		if createParent != "" {
@@ -93,11 +143,41 @@ var createCmd = &cobra.Command{
 			input.BlockedBy = createBlockedBy
 		}
 
+		// Add typed links
+		input.Links = links
+
 		// Add custom prefix
 		if createPrefix != "" {
 			input.Prefix = &createPrefix
 		}
 
+		// Check for possible duplicates
+		var warnings []string
+		threshold := cfg.GetDuplicateThreshold()
+		if threshold == 0 && createStrict {
+			threshold = config.DefaultDuplicateThreshold
+		}
+		if threshold > 0 {
+			duplicates, err := core.FindDuplicates(title, createTag, body, threshold)
+			if err != nil {
+				return cmdError(createJSON, output.ErrFileError, "checking for duplicates: %v", err)
+			}
+			if len(duplicates) > 0 {
+				candidates := beangraph.NewDuplicateCandidates(duplicates)
+				switch {
+				case createStrict:
+					return cmdError(createJSON, output.ErrConflict, "possible duplicate of %s", describeDuplicates(candidates))
+				case !createJSON && term.IsTerminal(int(os.Stdin.Fd())):
+					if !confirmCreateDuplicate(candidates) {
+						fmt.Println("Cancelled")
+						return nil
+					}
+				default:
+					warnings = append(warnings, "possible duplicate of "+describeDuplicates(candidates))
+				}
+			}
+		}
+
 		// Create via core resolver
 		resolver := &beangraph.CoreResolver{Core: core}
 		b, err := resolver.CreateBean(context.Background(), input)
@@ -106,16 +186,94 @@ var createCmd = &cobra.Command{
 		}
 
 		if createJSON {
-			return output.Success(b, "Bean created")
+			return output.SuccessWithWarnings(b, "Bean created", warnings)
 		}
 
 		fmt.Println(ui.Success.Render("Created ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
+		for _, w := range warnings {
+			fmt.Println(ui.Warning.Render("Warning: ") + w)
+		}
 		return nil
 	},
 }
 
+// describeDuplicates lists duplicate candidates in one line, e.g.
+// `abc1 "Login broken" (0.93)`.
+func describeDuplicates(candidates []beangraph.DuplicateCandidate) string {
+	parts := make([]string, len(candidates))
+	for i, c := range candidates {
+		parts[i] = fmt.Sprintf("%s %q (%.2f)", c.ID, c.Title, c.Score)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// confirmCreateDuplicate shows possible duplicates of a new bean and asks
+// whether to create it anyway.
+func confirmCreateDuplicate(candidates []beangraph.DuplicateCandidate) bool {
+	fmt.Println("Similar beans already exist:")
+	fmt.Print(formatDuplicateCandidates(candidates))
+	fmt.Print("Create anyway? [y/N] ")
+
+	reader := bufio.NewReader(os.Stdin)
+	response, _ := reader.ReadString('\n')
+	response = strings.TrimSpace(strings.ToLower(response))
+	return response == "y" || response == "yes"
+}
+
+// parseFieldFlags parses repeated --field name=value flags into field inputs.
+func parseFieldFlags(flags []string) ([]*model.FieldInput, error) {
+	var fields []*model.FieldInput
+	for _, flag := range flags {
+		name, value, ok := strings.Cut(flag, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid --field %q (use name=value)", flag)
+		}
+		fields = append(fields, &model.FieldInput{Name: strings.TrimSpace(name), Value: value})
+	}
+	return fields, nil
+}
+
+// parseLinkFlags parses --link values of the form type:id.
+func parseLinkFlags(flags []string) ([]*model.LinkInput, error) {
+	var links []*model.LinkInput
+	for _, flag := range flags {
+		linkType, targetID, ok := strings.Cut(flag, ":")
+		if !ok || strings.TrimSpace(linkType) == "" || strings.TrimSpace(targetID) == "" {
+			return nil, fmt.Errorf("invalid --link %q (use type:id, e.g. relates_to:abc1)", flag)
+		}
+		links = append(links, &model.LinkInput{Type: strings.TrimSpace(linkType), TargetID: strings.TrimSpace(targetID)})
+	}
+	return links, nil
+}
+
+// resolveAssignees replaces "me" in assignee flags with the current user's identity.
+func resolveAssignees(assignees []string) ([]string, error) {
+	resolved := make([]string, 0, len(assignees))
+	for _, a := range assignees {
+		if a == "me" {
+			user, err := currentUser()
+			if err != nil {
+				return nil, err
+			}
+			a = user
+		}
+		resolved = append(resolved, a)
+	}
+	return resolved, nil
+}
+
+// currentUser returns the current user's identity, or an error explaining
+// how to configure one.
+func currentUser() (string, error) {
+	if user := core.CurrentUser(); user != "" {
+		return user, nil
+	}
+	return "", fmt.Errorf("no user identity found (set BEANS_USER, beans.user in .beans.yml, or git config user.email)")
+}
+
 func RegisterCreateCmd(root *cobra.Command) {
-	// Build help text with allowed values from hardcoded config
+	// Build help text from the built-in values. Flags are registered before
+	// .beans.yml is loaded, so custom statuses and types can't be listed here.
 	statusNames := make([]string, len(config.DefaultStatuses))
 	for i, s := range config.DefaultStatuses {
 		statusNames[i] = s.Name
@@ -129,8 +287,8 @@ func RegisterCreateCmd(root *cobra.Command) {
 		priorityNames[i] = p.Name
 	}
 
-	createCmd.Flags().StringVarP(&createStatus, "status", "s", "", "Initial status ("+strings.Join(statusNames, ", ")+")")
-	createCmd.Flags().StringVarP(&createType, "type", "t", "", "Bean type ("+strings.Join(typeNames, ", ")+")")
+	createCmd.Flags().StringVarP(&createStatus, "status", "s", "", "Initial status ("+strings.Join(statusNames, ", ")+", or a custom status from .beans.yml)")
+	createCmd.Flags().StringVarP(&createType, "type", "t", "", "Bean type ("+strings.Join(typeNames, ", ")+", or a custom type from .beans.yml)")
 	createCmd.Flags().StringVarP(&createPriority, "priority", "p", "", "Priority level ("+strings.Join(priorityNames, ", ")+")")
 	createCmd.Flags().StringVarP(&createBody, "body", "d", "", "Body content (use '-' to read from stdin)")
 	createCmd.Flags().StringVar(&createBodyFile, "body-file", "", "Read body from file")
@@ -138,7 +296,14 @@ func RegisterCreateCmd(root *cobra.Command) {
 	createCmd.Flags().StringVar(&createParent, "parent", "", "Parent bean ID")
 	createCmd.Flags().StringArrayVar(&createBlocking, "blocking", nil, "ID of bean this blocks (can be repeated)")
 	createCmd.Flags().StringArrayVar(&createBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
+	createCmd.Flags().StringArrayVar(&createLink, "link", nil, "Typed link as type:id, e.g. relates_to:abc1 (relates_to, duplicates, caused_by, or a custom type; can be repeated)")
 	createCmd.Flags().StringVar(&createPrefix, "prefix", "", "Custom ID prefix (overrides config prefix)")
+	createCmd.Flags().StringArrayVar(&createAssignee, "assignee", nil, "Assign to a user, or 'me' for yourself (can be repeated)")
+	createCmd.Flags().StringVar(&createStart, "start", "", "Start date (YYYY-MM-DD or RFC 3339)")
+	createCmd.Flags().StringVar(&createDue, "due", "", "Due date (YYYY-MM-DD or RFC 3339)")
+	createCmd.Flags().Float64Var(&createEstimate, "estimate", 0, "Estimated effort (unit set by beans.estimate_unit, default points)")
+	createCmd.Flags().StringArrayVar(&createField, "field", nil, "Set a custom field declared in .beans.yml as name=value (can be repeated)")
+	createCmd.Flags().BoolVar(&createStrict, "strict", false, "Fail if similar beans already exist instead of asking")
 	createCmd.Flags().BoolVar(&createJSON, "json", false, "Output as JSON")
 	createCmd.MarkFlagsMutuallyExclusive("body", "body-file")
 	root.AddCommand(createCmd)
@@ -0,0 +1,377 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormat  string
+	exportColumns []string
+	exportOutput  string
+)
+
+// exportFormats lists the formats beans export can write.
+var exportFormats = []string{"csv", "jsonl", "ics"}
+
+// defaultExportColumns are the columns exported when neither --columns nor a
+// view with columns is given.
+var defaultExportColumns = []string{
+	"id", "title", "status", "type", "priority", "tags", "assignees", "parent",
+	"blocking", "blocked_by", "start", "due", "estimate", "created", "updated",
+}
+
+// extraExportColumns are the columns export offers on top of the view columns.
+var extraExportColumns = []string{"slug", "start", "source", "blocking", "blocked_by", "links", "body"}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export beans as CSV, JSON Lines or iCalendar",
+	Long: `Exports beans for spreadsheets and calendars. Beans are selected with the
+same filter, sort and view flags as beans list.
+
+Formats (--format):
+  csv    One row per bean, with a header row of column names
+  jsonl  One JSON object per bean and line, keyed by column name
+  ics    An iCalendar feed with an event for each milestone that has a start
+         or due date; other beans are left out
+
+Columns (--columns) apply to csv and jsonl. Besides the view columns (id,
+title, status, type, priority, tags, assignees, parent, due, estimate,
+created, updated and custom fields) export offers slug, start, source,
+blocking, blocked_by, links and body. Relationships are flattened to bean
+IDs, and links to type:id pairs. Without --columns, the columns of --view
+are used, or else a default set.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(exportFormats, exportFormat) {
+			return fmt.Errorf("invalid format: %s (must be %s)", exportFormat, strings.Join(exportFormats, ", "))
+		}
+
+		resolver := &beangraph.CoreResolver{Core: core}
+		beans, view, err := queryListBeans(resolver)
+		if err != nil {
+			return err
+		}
+
+		columns := defaultExportColumns
+		if view != nil && len(view.Columns) > 0 {
+			columns = view.Columns
+		}
+		if len(exportColumns) > 0 {
+			columns = exportColumns
+		}
+		for _, col := range columns {
+			if !isValidExportColumn(col) {
+				return fmt.Errorf("unknown column: %s (must be %s, or a custom field)", col, strings.Join(exportColumnNames(), ", "))
+			}
+		}
+
+		w := io.Writer(os.Stdout)
+		if exportOutput != "" && exportOutput != "-" {
+			f, err := os.Create(exportOutput)
+			if err != nil {
+				return cmdError(false, output.ErrFileError, "creating %s: %v", exportOutput, err)
+			}
+			defer f.Close()
+			w = f
+		}
+		buf := bufio.NewWriter(w)
+
+		switch exportFormat {
+		case "csv":
+			err = writeExportCSV(buf, resolver, beans, columns)
+		case "jsonl":
+			err = writeExportJSONL(buf, resolver, beans, columns)
+		case "ics":
+			err = writeExportICS(buf, beans, time.Now())
+		}
+		if err != nil {
+			return err
+		}
+		return buf.Flush()
+	},
+}
+
+// exportColumnNames returns the built-in columns export accepts.
+func exportColumnNames() []string {
+	names := slices.Clone(config.ViewColumns)
+	for _, col := range extraExportColumns {
+		if !slices.Contains(names, col) {
+			names = append(names, col)
+		}
+	}
+	return names
+}
+
+// isValidExportColumn returns true if col is a built-in export column or a
+// declared custom field.
+func isValidExportColumn(col string) bool {
+	return slices.Contains(extraExportColumns, col) || cfg.IsValidViewColumn(col)
+}
+
+// exportValue returns the value of a column for a bean: a string, or a list
+// of strings for columns with several values.
+func exportValue(resolver *beangraph.CoreResolver, b *bean.Bean, column string) (any, error) {
+	switch column {
+	case "slug":
+		return b.Slug, nil
+	case "source":
+		return b.Source, nil
+	case "body":
+		return strings.TrimSpace(b.Body), nil
+	case "tags":
+		return nonNil(b.Tags), nil
+	case "assignees":
+		return nonNil(b.Assignees), nil
+	case "start":
+		if b.StartAt != nil {
+			return bean.FormatDate(*b.StartAt), nil
+		}
+		return "", nil
+	case "created":
+		if b.CreatedAt != nil {
+			return b.CreatedAt.Format(time.RFC3339), nil
+		}
+		return "", nil
+	case "updated":
+		if b.UpdatedAt != nil {
+			return b.UpdatedAt.Format(time.RFC3339), nil
+		}
+		return "", nil
+	case "blocking":
+		blocking, err := resolver.BeanBlocking(context.Background(), b, nil)
+		if err != nil {
+			return nil, err
+		}
+		return beanIDs(blocking), nil
+	case "blocked_by":
+		blockers, err := resolver.BeanBlockedBy(context.Background(), b, nil)
+		if err != nil {
+			return nil, err
+		}
+		return beanIDs(blockers), nil
+	case "links":
+		links := []string{}
+		for _, linkType := range b.LinkTypes() {
+			for _, target := range b.Links[linkType] {
+				links = append(links, linkType+":"+target)
+			}
+		}
+		return links, nil
+	}
+	return columnValue(b, column), nil
+}
+
+// writeExportCSV writes beans as CSV with a header row. Columns with several
+// values are joined with commas.
+func writeExportCSV(w io.Writer, resolver *beangraph.CoreResolver, beans []*bean.Bean, columns []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	row := make([]string, len(columns))
+	for _, b := range beans {
+		for i, col := range columns {
+			value, err := exportValue(resolver, b, col)
+			if err != nil {
+				return err
+			}
+			if values, ok := value.([]string); ok {
+				row[i] = strings.Join(values, ", ")
+			} else {
+				row[i] = value.(string)
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeExportJSONL writes beans as JSON Lines, one object per bean with its
+// keys in column order.
+func writeExportJSONL(w io.Writer, resolver *beangraph.CoreResolver, beans []*bean.Bean, columns []string) error {
+	for _, b := range beans {
+		var sb strings.Builder
+		sb.WriteString("{")
+		for i, col := range columns {
+			value, err := exportValue(resolver, b, col)
+			if err != nil {
+				return err
+			}
+			key, _ := json.Marshal(col)
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.Write(key)
+			sb.WriteString(":")
+			sb.Write(data)
+		}
+		sb.WriteString("}\n")
+		if _, err := io.WriteString(w, sb.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeExportICS writes an iCalendar feed with an event for each milestone
+// that has a start or due date. Date-only milestones become all-day events.
+func writeExportICS(w io.Writer, beans []*bean.Bean, now time.Time) error {
+	var lines []string
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//beans//export//EN",
+		"CALSCALE:GREGORIAN",
+	)
+	if name := cfg.GetProjectName(); name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+icsEscape(name))
+	}
+
+	for _, b := range beans {
+		if b.Type != "milestone" || (b.StartAt == nil && b.DueAt == nil) {
+			continue
+		}
+
+		start, end := b.StartAt, b.DueAt
+		if start == nil {
+			start, end = end, nil
+		}
+		allDay := isDateOnly(*start) && (end == nil || isDateOnly(*end))
+
+		stamp := now
+		if b.UpdatedAt != nil {
+			stamp = *b.UpdatedAt
+		}
+
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+b.ID+"@beans",
+			"DTSTAMP:"+icsDateTime(stamp),
+		)
+		if allDay {
+			// DTEND is exclusive, so an all-day event ends the day after
+			last := *start
+			if end != nil {
+				last = *end
+			}
+			lines = append(lines,
+				"DTSTART;VALUE=DATE:"+start.Format("20060102"),
+				"DTEND;VALUE=DATE:"+last.AddDate(0, 0, 1).Format("20060102"),
+			)
+		} else {
+			lines = append(lines, "DTSTART:"+icsDateTime(*start))
+			if end != nil {
+				lines = append(lines, "DTEND:"+icsDateTime(*end))
+			}
+		}
+		lines = append(lines, "SUMMARY:"+icsEscape(b.Title))
+		if body := strings.TrimSpace(b.Body); body != "" {
+			lines = append(lines, "DESCRIPTION:"+icsEscape(body))
+		}
+		if len(b.Tags) > 0 {
+			tags := make([]string, len(b.Tags))
+			for i, tag := range b.Tags {
+				tags[i] = icsEscape(tag)
+			}
+			lines = append(lines, "CATEGORIES:"+strings.Join(tags, ","))
+		}
+		if b.Source != "" {
+			lines = append(lines, "URL:"+b.Source)
+		}
+		status := "CONFIRMED"
+		if b.Status == "scrapped" {
+			status = "CANCELLED"
+		}
+		lines = append(lines, "STATUS:"+status, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, icsFold(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isDateOnly reports whether t is midnight UTC, i.e. was given as a date.
+func isDateOnly(t time.Time) bool {
+	return t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour))
+}
+
+// icsDateTime formats t as an iCalendar UTC date-time.
+func icsDateTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icsEscape escapes an iCalendar text value.
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// icsFold folds a content line into lines of at most 75 octets, continued
+// with a leading space, without splitting UTF-8 sequences.
+func icsFold(line string) string {
+	const limit = 75
+	var sb strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			sb.WriteString("\r\n ")
+			width = 1
+		}
+		sb.WriteRune(r)
+		width += size
+	}
+	return sb.String()
+}
+
+// beanIDs returns the IDs of beans.
+func beanIDs(beans []*bean.Bean) []string {
+	ids := make([]string, len(beans))
+	for i, b := range beans {
+		ids[i] = b.ID
+	}
+	return ids
+}
+
+// nonNil returns s, or an empty slice if s is nil, so that it encodes as an
+// empty JSON array.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func RegisterExportCmd(root *cobra.Command) {
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", "csv", "Output format: csv, jsonl or ics")
+	exportCmd.Flags().StringSliceVar(&exportColumns, "columns", nil, "Comma-separated columns to export (csv and jsonl)")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to a file instead of stdout")
+	addListFilterFlags(exportCmd)
+	root.AddCommand(exportCmd)
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/config"
+)
+
+func TestWriteExportCSV(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	cfg = config.Default()
+	defer func() { cfg = oldCfg }()
+
+	blocker := createQueryTestBean(t, testCore, "exp-1", "Set up database", "todo")
+	blocked := &bean.Bean{ID: "exp-2", Title: "Store users, with care", Status: "todo", Tags: []string{"backend", "db"}, Parent: "exp-3"}
+	if err := testCore.Create(blocked); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	blocker.Blocking = []string{"exp-2"}
+	if err := testCore.Update(blocker, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	resolver := &beangraph.CoreResolver{Core: testCore}
+	var sb strings.Builder
+	columns := []string{"id", "title", "tags", "parent", "blocking", "blocked_by"}
+	if err := writeExportCSV(&sb, resolver, []*bean.Bean{blocker, blocked}, columns); err != nil {
+		t.Fatalf("writeExportCSV() error = %v", err)
+	}
+
+	want := "id,title,tags,parent,blocking,blocked_by\n" +
+		"exp-1,Set up database,,,exp-2,\n" +
+		"exp-2,\"Store users, with care\",\"backend, db\",exp-3,,exp-1\n"
+	if sb.String() != want {
+		t.Errorf("writeExportCSV() =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestWriteExportJSONL(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	cfg = config.Default()
+	defer func() { cfg = oldCfg }()
+
+	due := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	b := &bean.Bean{
+		ID: "exp-1", Title: "Ship it", Status: "todo", DueAt: &due,
+		Links: map[string][]string{"relates_to": {"exp-2"}},
+	}
+	if err := testCore.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	resolver := &beangraph.CoreResolver{Core: testCore}
+	var sb strings.Builder
+	columns := []string{"title", "id", "due", "tags", "links"}
+	if err := writeExportJSONL(&sb, resolver, []*bean.Bean{b}, columns); err != nil {
+		t.Fatalf("writeExportJSONL() error = %v", err)
+	}
+
+	want := `{"title":"Ship it","id":"exp-1","due":"2026-03-01","tags":[],"links":["relates_to:exp-2"]}` + "\n"
+	if sb.String() != want {
+		t.Errorf("writeExportJSONL() = %s, want %s", sb.String(), want)
+	}
+}
+
+func TestWriteExportICS(t *testing.T) {
+	oldCfg := cfg
+	cfg = config.Default()
+	defer func() { cfg = oldCfg }()
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	demo := time.Date(2026, 4, 2, 15, 30, 0, 0, time.UTC)
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	beans := []*bean.Bean{
+		{ID: "m-1", Title: "Beta; public", Type: "milestone", Status: "todo", StartAt: &start, DueAt: &due, Body: "\nFirst line\nSecond line\n"},
+		{ID: "m-2", Title: "Demo", Type: "milestone", Status: "scrapped", DueAt: &demo},
+		{ID: "m-3", Title: "Someday", Type: "milestone", Status: "todo"},
+		{ID: "t-1", Title: "Task with a date", Type: "task", Status: "todo", DueAt: &due},
+	}
+
+	var sb strings.Builder
+	if err := writeExportICS(&sb, beans, now); err != nil {
+		t.Fatalf("writeExportICS() error = %v", err)
+	}
+	got := sb.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:m-1@beans\r\nDTSTAMP:20260201T120000Z\r\nDTSTART;VALUE=DATE:20260301\r\nDTEND;VALUE=DATE:20260315\r\n",
+		"SUMMARY:Beta\\; public\r\n",
+		"DESCRIPTION:First line\\nSecond line\r\n",
+		"UID:m-2@beans\r\nDTSTAMP:20260201T120000Z\r\nDTSTART:20260402T153000Z\r\nSUMMARY:Demo\r\nSTATUS:CANCELLED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("writeExportICS() missing %q in:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("writeExportICS() wrote %d events, want 2 (milestones with dates only)", n)
+	}
+}
+
+func TestICSFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 50)
+	folded := icsFold(line)
+	for _, part := range strings.Split(folded, "\r\n") {
+		if len(part) > 75 {
+			t.Errorf("folded line is %d octets, want at most 75: %q", len(part), part)
+		}
+	}
+	if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != line {
+		t.Errorf("unfolded = %q, want %q", unfolded, line)
+	}
+}
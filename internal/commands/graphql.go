@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -16,6 +17,7 @@ import (
 	"github.com/vektah/gqlparser/v2/formatter"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
 	"github.com/hmans/beans/pkg/beangraph"
 )
 
@@ -163,10 +165,37 @@ func executeQuery(query string, variables map[string]any, operationName string)
 	if len(resp.Errors) > 0 {
 		return nil, formatGraphQLErrors(resp.Errors)
 	}
+	if duplicates, ok := resp.Extensions[beangraph.DuplicatesExtension].(map[string][]beangraph.DuplicateCandidate); ok {
+		printDuplicateWarnings(os.Stderr, duplicates)
+	}
 
 	return resp.Data, nil
 }
 
+// printDuplicateWarnings warns about possible duplicates of created beans, by
+// created bean ID.
+func printDuplicateWarnings(w io.Writer, duplicates map[string][]beangraph.DuplicateCandidate) {
+	ids := make([]string, 0, len(duplicates))
+	for id := range duplicates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		fmt.Fprintln(w, ui.Warning.Render("Warning: ")+"created bean "+ui.ID.Render(id)+" may duplicate:")
+		fmt.Fprint(w, formatDuplicateCandidates(duplicates[id]))
+	}
+}
+
+// formatDuplicateCandidates renders one line per duplicate candidate.
+func formatDuplicateCandidates(candidates []beangraph.DuplicateCandidate) string {
+	var sb strings.Builder
+	for _, c := range candidates {
+		fmt.Fprintf(&sb, "  %s  %s  %s  %s\n",
+			ui.Muted.Render(fmt.Sprintf("%.2f", c.Score)), ui.ID.Render(c.ID), ui.Muted.Render(c.Status), c.Title)
+	}
+	return sb.String()
+}
+
 // formatGraphQLErrors formats GraphQL errors into a single error.
 func formatGraphQLErrors(errs gqlerror.List) error {
 	if len(errs) == 0 {
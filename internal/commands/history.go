@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/spf13/cobra"
+)
+
+var historyJSON bool
+
+var historyCmd = &cobra.Command{
+	Use:   "history <id>",
+	Short: "Show the change history of a bean",
+	Long: `Shows every commit that touched a bean's file, newest first, following renames
+and moves into or out of the archive. Each entry lists the author, timestamp and
+the fields that changed (e.g. status todo → in-progress, added tags, body edits).
+
+Only committed changes are shown; the project must be in a git repository.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := core.History(args[0])
+		if errors.Is(err, beancore.ErrNotFound) {
+			return cmdError(historyJSON, output.ErrNotFound, "bean not found: %s", args[0])
+		}
+		if err != nil {
+			return cmdError(historyJSON, output.ErrFileError, "%s", err)
+		}
+
+		if historyJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(entries)
+		}
+
+		if len(entries) == 0 {
+			fmt.Println(ui.Muted.Render("No committed history for this bean yet."))
+			return nil
+		}
+		for i, e := range entries {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Print(formatHistoryEntry(e))
+		}
+		return nil
+	},
+}
+
+// formatHistoryEntry renders a history entry as a header line, the commit
+// message, and one line per field change.
+func formatHistoryEntry(e beancore.HistoryEntry) string {
+	var sb strings.Builder
+	sb.WriteString(ui.ID.Render(e.Commit[:min(7, len(e.Commit))]))
+	sb.WriteString("  ")
+	sb.WriteString(ui.Muted.Render(e.Timestamp.Local().Format("2006-01-02 15:04")))
+	sb.WriteString("  ")
+	sb.WriteString(e.Author)
+	sb.WriteString("  ")
+	sb.WriteString(ui.Bold.Render(e.Action))
+	sb.WriteString("\n")
+	if e.Message != "" {
+		sb.WriteString("  " + ui.Muted.Render(e.Message) + "\n")
+	}
+	for _, c := range e.Changes {
+		sb.WriteString("  " + formatFieldChange(c) + "\n")
+	}
+	return sb.String()
+}
+
+// formatFieldChange renders a single field change, e.g. "status: todo → in-progress".
+func formatFieldChange(c bean.FieldChange) string {
+	switch {
+	case c.Field == "body":
+		return fmt.Sprintf("body: %s %s lines",
+			ui.Success.Render(fmt.Sprintf("+%d", len(c.Added))),
+			ui.Danger.Render(fmt.Sprintf("-%d", len(c.Removed))))
+	case c.Added != nil || c.Removed != nil:
+		var parts []string
+		for _, v := range c.Added {
+			parts = append(parts, ui.Success.Render("+"+v))
+		}
+		for _, v := range c.Removed {
+			parts = append(parts, ui.Danger.Render("-"+v))
+		}
+		return c.Field + ": " + strings.Join(parts, " ")
+	default:
+		from, to := c.From, c.To
+		if from == "" {
+			from = "∅"
+		}
+		if to == "" {
+			to = "∅"
+		}
+		return fmt.Sprintf("%s: %s → %s", c.Field, from, to)
+	}
+}
+
+func RegisterHistoryCmd(root *cobra.Command) {
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "Output as JSON")
+	root.AddCommand(historyCmd)
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hmans/beans/internal/issues"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/forge"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importRepo   string
+	importDryRun bool
+	importJSON   bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import beans from other issue trackers",
+}
+
+var importGithubCmd = &cobra.Command{
+	Use:   "github <file.json>",
+	Short: "Import GitHub issues from a gh issue list export",
+	Long: `Imports issues from the JSON written by 'gh issue list --json' (or 'gh issue view
+--json'), read from a file or from stdin with '-'. No network access is needed.
+
+Labels naming a bean type (bug, feature, task, "type: bug", "enhancement", ...)
+set the type; the other labels become tags. Milestones become milestone beans
+and parents of their issues. Open issues get the default status; closed ones
+become completed, or scrapped if closed as not planned.
+
+Every bean records its issue's URL as its source, so importing again updates
+the existing beans instead of duplicating them. Local progress such as
+in-progress is kept unless the issue was closed or reopened.`,
+	Example: `  gh issue list --state all --limit 1000 --json ` + forge.GitHubIssueFields + ` > issues.json
+  beans import github issues.json
+  gh issue list --json ` + forge.GitHubIssueFields + ` | beans import github -`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var data []byte
+		var err error
+		if args[0] == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(args[0])
+		}
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "reading issues: %s", err)
+		}
+
+		ghIssues, err := forge.ParseGitHubIssues(data)
+		if err != nil {
+			return cmdError(importJSON, output.ErrValidation, "%s", err)
+		}
+
+		result, err := issues.ImportGitHub(core, ghIssues, issues.Options{Repo: importRepo, DryRun: importDryRun})
+		if err != nil {
+			return cmdError(importJSON, output.ErrValidation, "%s", err)
+		}
+
+		if importJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(result)
+		}
+
+		for _, b := range result.Created {
+			printImported("created", b)
+		}
+		for _, b := range result.Updated {
+			printImported("updated", b)
+		}
+		for _, w := range result.Warnings {
+			fmt.Println(ui.Warning.Render("Warning: ") + w)
+		}
+		summary := fmt.Sprintf("%d created, %d updated, %d unchanged", len(result.Created), len(result.Updated), len(result.Unchanged))
+		if importDryRun {
+			summary += " (dry run, nothing written)"
+		}
+		fmt.Println(ui.Muted.Render(summary))
+		return nil
+	},
+}
+
+// printImported prints one line for a bean created or updated by an import.
+func printImported(action string, b *bean.Bean) {
+	fmt.Println(ui.Success.Render(fmt.Sprintf("%-8s", action)) + " " + ui.ID.Render(b.ID) + "  " + b.Title + "  " + ui.Muted.Render(b.Source))
+}
+
+func RegisterImportCmd(root *cobra.Command) {
+	importGithubCmd.Flags().StringVar(&importRepo, "repo", "", "Repository as owner/repo, for exports without the url field")
+	importGithubCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without writing anything")
+	importGithubCmd.Flags().BoolVar(&importJSON, "json", false, "Output as JSON")
+	importCmd.AddCommand(importGithubCmd)
+	root.AddCommand(importCmd)
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"sort"
+	"strings"
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph"
@@ -27,6 +27,17 @@ var (
 	listNoPriority []string
 	listTag        []string
 	listNoTag      []string
+	listField      []string
+	listAssignee   []string
+	listUnassigned bool
+	listMine       bool
+	listNoField    []string
+	listLink       []string
+	listLinkedFrom []string
+	listNoLink     []string
+	listDueBefore  string
+	listDueAfter   string
+	listOverdue    bool
 	listHasParent   bool
 	listNoParent    bool
 	listParentID    string
@@ -37,6 +48,8 @@ var (
 	listQuiet      bool
 	listSort       string
 	listFull       bool
+	listView       string
+	listWhere      string
 )
 
 var listCmd = &cobra.Command{
@@ -57,70 +70,39 @@ Search Syntax (--search/-S):
   user OR login  Either term matches
   slug:auth      Search only in slug field
   title:login    Search only in title field
-  body:auth      Search only in body field`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		// Build GraphQL filter from CLI flags
-		filter := &model.BeanFilter{
-			Status:          listStatus,
-			ExcludeStatus:   listNoStatus,
-			Type:            listType,
-			ExcludeType:     listNoType,
-			Priority:        listPriority,
-			ExcludePriority: listNoPriority,
-			Tags:            listTag,
-			ExcludeTags:     listNoTag,
-		}
+  body:auth      Search only in body field
+  tags:auth      Beans tagged auth (tags are also searched without a field)
+  type:bug       Beans of a type (also status:todo)
+  archived:true  Only archived beans
 
-		// Add search filter if provided
-		if listSearch != "" {
-			filter.Search = &listSearch
-		}
+Query Expressions (--where):
+  Compare fields with =, !=, <, <=, >, >=, ~ (contains) and in (...), and
+  combine comparisons with AND, OR, NOT and parentheses. A field on its own
+  matches beans where it is set. --where is combined with the other flags.
 
-		// Add parent/blocks filters
-		if listHasParent {
-			filter.HasParent = &listHasParent
-		}
-		if listNoParent {
-			filter.NoParent = &listNoParent
-		}
-		if listParentID != "" {
-			filter.ParentID = &listParentID
-		}
-		if listHasBlocking {
-			filter.HasBlocking = &listHasBlocking
-		}
-		if listNoBlocking {
-			filter.NoBlocking = &listNoBlocking
-		}
-		// --ready and --is-blocked are mutually exclusive
-		if listReady && listIsBlocked {
-			return fmt.Errorf("--ready and --is-blocked are mutually exclusive")
-		}
-
-		if listIsBlocked {
-			filter.IsBlocked = &listIsBlocked
-		}
+  Fields: id, slug, title, body, status, type, priority, tags, assignees,
+  parent, source, created, updated, start, due, estimate, archived, blocked,
+  overdue, and custom fields. Follow relationships with dots: parent.type,
+  children.status, blocking.status, blocked_by.status, or a link type such
+  as duplicates.status. Dates accept YYYY-MM-DD, today, now, or relative
+  times like -7d and +2w. Priorities compare by urgency (critical > high).
 
-		// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft,
-		// and excludes beans with implicit terminal status from a scrapped/completed ancestor)
-		if listReady {
-			isBlocked := false
-			excludeImplicitTerminal := true
-			filter.IsBlocked = &isBlocked
-			filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "completed", "scrapped", "draft")
-			filter.ExcludeImplicitTerminal = &excludeImplicitTerminal
-		}
+  (type = bug AND priority >= high) OR (type = feature AND tags = security)
+  parent.type = epic AND NOT archived
+  status in (todo, draft) AND created > -7d
+  due < today AND NOT status in (completed, scrapped)
 
-		// Execute query via core resolver
+Views (--view):
+  Named views declared under views in .beans.yml save a filter, a sort order
+  and the columns to show. Filter flags given alongside --view replace the
+  view's setting for the same filter, and --sort replaces its sort order.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		resolver := &beangraph.CoreResolver{Core: core}
-		beans, err := resolver.Beans(context.Background(), filter)
+		beans, view, err := queryListBeans(resolver)
 		if err != nil {
-			return fmt.Errorf("querying beans: %w", err)
+			return err
 		}
 
-		// Sort beans
-		sortBeans(beans, listSort, cfg)
-
 		// JSON output (flat list)
 		if listJSON {
 			if !listFull {
@@ -139,6 +121,16 @@ Search Syntax (--search/-S):
 			return nil
 		}
 
+		// Views with columns render a flat table
+		if view != nil && len(view.Columns) > 0 {
+			if len(beans) == 0 {
+				fmt.Println(ui.Muted.Render("No beans match this view."))
+				return nil
+			}
+			fmt.Print(renderColumns(beans, view.Columns))
+			return nil
+		}
+
 		// Default: tree view
 		// We need all beans to find ancestors for context
 		allBeans, err := resolver.Beans(context.Background(), nil)
@@ -156,7 +148,7 @@ Search Syntax (--search/-S):
 
 		// Create sort function for tree building
 		sortFn := func(b []*bean.Bean) {
-			sortBeans(b, listSort, cfg)
+			beangraph.SortBeans(b, listSort, cfg)
 		}
 
 		// Build tree
@@ -196,91 +188,266 @@ Search Syntax (--search/-S):
 	},
 }
 
-func sortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) {
-	statusNames := cfg.StatusNames()
-	priorityNames := cfg.PriorityNames()
-	typeNames := cfg.TypeNames()
+// queryListBeans runs the beans query for the list flags, applying --view
+// if given, and returns the sorted beans along with the view.
+func queryListBeans(resolver *beangraph.CoreResolver) ([]*bean.Bean, *config.ViewConfig, error) {
+	filter, err := buildListFilter()
+	if err != nil {
+		return nil, nil, err
+	}
 
-	switch sortBy {
-	case "created":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].CreatedAt == nil && beans[j].CreatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].CreatedAt == nil {
-				return false
-			}
-			if beans[j].CreatedAt == nil {
-				return true
-			}
-			return beans[i].CreatedAt.After(*beans[j].CreatedAt)
-		})
-	case "updated":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].UpdatedAt == nil && beans[j].UpdatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].UpdatedAt == nil {
-				return false
-			}
-			if beans[j].UpdatedAt == nil {
-				return true
+	var view *config.ViewConfig
+	if listView != "" {
+		if view = cfg.GetView(listView); view == nil {
+			names := cfg.ViewNames()
+			if len(names) == 0 {
+				return nil, nil, fmt.Errorf("unknown view: %s (no views are declared in %s)", listView, config.ConfigFileName)
 			}
-			return beans[i].UpdatedAt.After(*beans[j].UpdatedAt)
-		})
-	case "status":
-		// Build status order from configured statuses
-		statusOrder := make(map[string]int)
-		for i, s := range statusNames {
-			statusOrder[s] = i
+			return nil, nil, fmt.Errorf("unknown view: %s (must be %s)", listView, strings.Join(names, ", "))
 		}
-		sort.Slice(beans, func(i, j int) bool {
-			oi, oj := statusOrder[beans[i].Status], statusOrder[beans[j].Status]
-			if oi != oj {
-				return oi < oj
-			}
-			return beans[i].ID < beans[j].ID
-		})
-	case "priority":
-		// Build priority order from configured priorities
-		priorityOrder := make(map[string]int)
-		for i, p := range priorityNames {
-			priorityOrder[p] = i
+		viewFilter, err := resolver.ViewFilter(view)
+		if err != nil {
+			return nil, nil, err
 		}
-		// Find normal priority index for beans without priority
-		normalIdx := len(priorityNames)
-		for i, p := range priorityNames {
-			if p == "normal" {
-				normalIdx = i
-				break
-			}
+		if filter, err = beangraph.MergeFilters(viewFilter, filter); err != nil {
+			return nil, nil, err
 		}
-		sort.Slice(beans, func(i, j int) bool {
-			pi := normalIdx
-			if beans[i].Priority != "" {
-				if order, ok := priorityOrder[beans[i].Priority]; ok {
-					pi = order
-				}
-			}
-			pj := normalIdx
-			if beans[j].Priority != "" {
-				if order, ok := priorityOrder[beans[j].Priority]; ok {
-					pj = order
-				}
-			}
-			if pi != pj {
-				return pi < pj
+		if listSort == "" {
+			listSort = view.Sort
+		}
+	}
+
+	beans, err := resolver.Beans(context.Background(), filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("querying beans: %w", err)
+	}
+	beangraph.SortBeans(beans, listSort, cfg)
+	return beans, view, nil
+}
+
+// buildListFilter builds the GraphQL filter from the list flags.
+func buildListFilter() (*model.BeanFilter, error) {
+	// Build GraphQL filter from CLI flags
+	filter := &model.BeanFilter{
+		Status:          listStatus,
+		ExcludeStatus:   listNoStatus,
+		Type:            listType,
+		ExcludeType:     listNoType,
+		Priority:        listPriority,
+		ExcludePriority: listNoPriority,
+		Tags:            listTag,
+		ExcludeTags:     listNoTag,
+	}
+
+	// Assignee filters (--mine is shorthand for --assignee me)
+	assignees := listAssignee
+	if listMine {
+		assignees = append(assignees, "me")
+	}
+	resolvedAssignees, err := resolveAssignees(assignees)
+	if err != nil {
+		return nil, err
+	}
+	filter.Assignee = resolvedAssignees
+	if listUnassigned {
+		filter.Unassigned = &listUnassigned
+	}
+
+	filter.Fields = parseFieldFilters(listField)
+	filter.ExcludeFields = parseFieldFilters(listNoField)
+	filter.HasLinks = parseLinkFilters(listLink)
+	filter.LinkedFrom = parseLinkFilters(listLinkedFrom)
+	filter.NoLinks = listNoLink
+
+	// Due date filters
+	if listDueBefore != "" {
+		dueBefore, err := bean.ParseDate(listDueBefore)
+		if err != nil {
+			return nil, err
+		}
+		filter.DueBefore = &dueBefore
+	}
+	if listDueAfter != "" {
+		dueAfter, err := bean.ParseDate(listDueAfter)
+		if err != nil {
+			return nil, err
+		}
+		filter.DueAfter = &dueAfter
+	}
+	if listOverdue {
+		filter.Overdue = &listOverdue
+	}
+
+	// Add search filter if provided
+	if listSearch != "" {
+		filter.Search = &listSearch
+	}
+	if listWhere != "" {
+		filter.Where = &listWhere
+	}
+
+	// Add parent/blocks filters
+	if listHasParent {
+		filter.HasParent = &listHasParent
+	}
+	if listNoParent {
+		filter.NoParent = &listNoParent
+	}
+	if listParentID != "" {
+		filter.ParentID = &listParentID
+	}
+	if listHasBlocking {
+		filter.HasBlocking = &listHasBlocking
+	}
+	if listNoBlocking {
+		filter.NoBlocking = &listNoBlocking
+	}
+	// --ready and --is-blocked are mutually exclusive
+	if listReady && listIsBlocked {
+		return nil, fmt.Errorf("--ready and --is-blocked are mutually exclusive")
+	}
+
+	if listIsBlocked {
+		filter.IsBlocked = &listIsBlocked
+	}
+
+	// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft,
+	// and excludes beans with implicit terminal status from a scrapped/completed ancestor)
+	if listReady {
+		isBlocked := false
+		excludeImplicitTerminal := true
+		filter.IsBlocked = &isBlocked
+		filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "completed", "scrapped", "draft")
+		filter.ExcludeImplicitTerminal = &excludeImplicitTerminal
+	}
+
+	return filter, nil
+}
+
+// parseFieldFilters turns repeated name=value (or bare name) flags into
+// field filters. Values given for the same field are ORed together.
+func parseFieldFilters(flags []string) []*model.FieldFilter {
+	var filters []*model.FieldFilter
+	byName := make(map[string]*model.FieldFilter)
+	for _, flag := range flags {
+		name, value, hasValue := strings.Cut(flag, "=")
+		f, ok := byName[name]
+		if !ok {
+			f = &model.FieldFilter{Name: name}
+			byName[name] = f
+			filters = append(filters, f)
+		}
+		if hasValue {
+			f.Values = append(f.Values, value)
+		}
+	}
+	return filters
+}
+
+// parseLinkFilters turns repeated type:id (or bare type) flags into link
+// filters. A bare type matches a link of that type to any bean.
+func parseLinkFilters(flags []string) []*model.LinkFilter {
+	var filters []*model.LinkFilter
+	for _, flag := range flags {
+		linkType, id, hasID := strings.Cut(flag, ":")
+		f := &model.LinkFilter{Type: linkType}
+		if hasID && id != "" {
+			f.ID = &id
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// renderColumns renders beans as a table with one row per bean and the given
+// columns, padded to the widest value in each column.
+func renderColumns(beans []*bean.Bean, columns []string) string {
+	rows := make([][]string, len(beans))
+	widths := make([]int, len(columns))
+	for i, col := range columns {
+		widths[i] = len(col)
+	}
+	for r, b := range beans {
+		rows[r] = make([]string, len(columns))
+		for i, col := range columns {
+			rows[r][i] = columnValue(b, col)
+			widths[i] = max(widths[i], len([]rune(rows[r][i])))
+		}
+	}
+
+	var sb strings.Builder
+	for i, col := range columns {
+		sb.WriteString(padCell(ui.Muted.Render(strings.ToUpper(col)), len(col), widths[i], i == len(columns)-1))
+	}
+	sb.WriteString("\n")
+	for r, b := range beans {
+		for i, col := range columns {
+			value := rows[r][i]
+			styled := value
+			switch col {
+			case "id":
+				styled = ui.ID.Render(value)
+			case "status":
+				styled = ui.RenderStatusText(cfg, b.Status)
+			case "title":
+				styled = ui.Title.Render(value)
 			}
-			return beans[i].ID < beans[j].ID
-		})
+			sb.WriteString(padCell(styled, len([]rune(value)), widths[i], i == len(columns)-1))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// padCell pads a styled cell of the given visible length to width, followed
+// by a column gap. The last cell in a row is not padded.
+func padCell(styled string, length, width int, last bool) string {
+	if last {
+		return styled
+	}
+	return styled + strings.Repeat(" ", width-length+2)
+}
+
+// columnValue returns the plain text of a view column for a bean. Unknown
+// columns are custom fields.
+func columnValue(b *bean.Bean, column string) string {
+	switch column {
 	case "id":
-		sort.Slice(beans, func(i, j int) bool {
-			return beans[i].ID < beans[j].ID
-		})
+		return b.ID
+	case "title":
+		return b.Title
+	case "status":
+		return b.Status
+	case "type":
+		return b.Type
+	case "priority":
+		return b.Priority
+	case "tags":
+		return strings.Join(b.Tags, ", ")
+	case "assignees":
+		return strings.Join(b.Assignees, ", ")
+	case "parent":
+		return b.Parent
+	case "due":
+		if b.DueAt != nil {
+			return bean.FormatDate(*b.DueAt)
+		}
+	case "estimate":
+		if b.Estimate > 0 {
+			return bean.FormatEstimate(b.Estimate)
+		}
+	case "created":
+		if b.CreatedAt != nil {
+			return b.CreatedAt.Format("2006-01-02")
+		}
+	case "updated":
+		if b.UpdatedAt != nil {
+			return b.UpdatedAt.Format("2006-01-02")
+		}
 	default:
-		// Default: sort by status order, then priority, then type order, then title (same as TUI)
-		bean.SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+		return b.Fields[column]
 	}
+	return ""
 }
 
 func truncate(s string, maxLen int) string {
@@ -292,24 +459,45 @@ func truncate(s string, maxLen int) string {
 
 func RegisterListCmd(root *cobra.Command) {
 	listCmd.Flags().BoolVar(&listJSON, "json", false, "Output as JSON")
-	listCmd.Flags().StringVarP(&listSearch, "search", "S", "", "Full-text search in title and body")
-	listCmd.Flags().StringArrayVarP(&listStatus, "status", "s", nil, "Filter by status (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoStatus, "no-status", nil, "Exclude by status (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listType, "type", "t", nil, "Filter by type (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoType, "no-type", nil, "Exclude by type (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listPriority, "priority", "p", nil, "Filter by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listTag, "tag", nil, "Filter by tag (can be repeated, OR logic)")
-	listCmd.Flags().StringArrayVar(&listNoTag, "no-tag", nil, "Exclude beans with tag (can be repeated)")
-	listCmd.Flags().BoolVar(&listHasParent, "has-parent", false, "Filter beans with a parent")
-	listCmd.Flags().BoolVar(&listNoParent, "no-parent", false, "Filter beans without a parent")
-	listCmd.Flags().StringVar(&listParentID, "parent", "", "Filter by parent ID")
-	listCmd.Flags().BoolVar(&listHasBlocking, "has-blocking", false, "Filter beans that are blocking others")
-	listCmd.Flags().BoolVar(&listNoBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
-	listCmd.Flags().BoolVar(&listIsBlocked, "is-blocked", false, "Filter beans that are blocked by others")
-	listCmd.Flags().BoolVar(&listReady, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)")
+	addListFilterFlags(listCmd)
 	listCmd.Flags().BoolVarP(&listQuiet, "quiet", "q", false, "Only output IDs (one per line)")
-	listCmd.Flags().StringVar(&listSort, "sort", "", "Sort by: created, updated, status, priority, id (default: status, priority, type, title)")
 	listCmd.Flags().BoolVar(&listFull, "full", false, "Include bean body in JSON output")
 	root.AddCommand(listCmd)
 }
+
+// addListFilterFlags registers the filter, sort and view flags shared by
+// list and export, so both query beans the same way.
+func addListFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&listSearch, "search", "S", "", "Full-text search in title and body")
+	cmd.Flags().StringArrayVarP(&listStatus, "status", "s", nil, "Filter by status (can be repeated)")
+	cmd.Flags().StringArrayVar(&listNoStatus, "no-status", nil, "Exclude by status (can be repeated)")
+	cmd.Flags().StringArrayVarP(&listType, "type", "t", nil, "Filter by type (can be repeated)")
+	cmd.Flags().StringArrayVar(&listNoType, "no-type", nil, "Exclude by type (can be repeated)")
+	cmd.Flags().StringArrayVarP(&listPriority, "priority", "p", nil, "Filter by priority (can be repeated)")
+	cmd.Flags().StringArrayVar(&listNoPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
+	cmd.Flags().StringArrayVar(&listTag, "tag", nil, "Filter by tag (can be repeated, OR logic)")
+	cmd.Flags().StringArrayVar(&listNoTag, "no-tag", nil, "Exclude beans with tag (can be repeated)")
+	cmd.Flags().StringArrayVar(&listAssignee, "assignee", nil, "Filter by assignee, or 'me' for yourself (can be repeated, OR logic)")
+	cmd.Flags().BoolVar(&listUnassigned, "unassigned", false, "Filter beans without assignees")
+	cmd.Flags().BoolVar(&listMine, "mine", false, "Filter beans assigned to you (BEANS_USER, beans.user config, or git user.email)")
+	cmd.MarkFlagsMutuallyExclusive("mine", "unassigned")
+	cmd.MarkFlagsMutuallyExclusive("assignee", "unassigned")
+	cmd.Flags().StringArrayVar(&listField, "field", nil, "Filter by custom field as name=value, or name to require it is set (can be repeated)")
+	cmd.Flags().StringArrayVar(&listNoField, "no-field", nil, "Exclude by custom field as name=value, or name to require it is unset (can be repeated)")
+	cmd.Flags().StringArrayVar(&listLink, "link", nil, "Filter beans with a typed link as type:id, or type for any target (can be repeated)")
+	cmd.Flags().StringArrayVar(&listLinkedFrom, "linked-from", nil, "Filter beans targeted by a typed link as type:id, or type for any source (can be repeated)")
+	cmd.Flags().StringArrayVar(&listNoLink, "no-link", nil, "Exclude beans with links of a type (can be repeated)")
+	cmd.Flags().StringVar(&listDueBefore, "due-before", "", "Filter beans due before a date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&listDueAfter, "due-after", "", "Filter beans due after a date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().BoolVar(&listOverdue, "overdue", false, "Filter beans past their due date that are not completed or scrapped")
+	cmd.Flags().BoolVar(&listHasParent, "has-parent", false, "Filter beans with a parent")
+	cmd.Flags().BoolVar(&listNoParent, "no-parent", false, "Filter beans without a parent")
+	cmd.Flags().StringVar(&listParentID, "parent", "", "Filter by parent ID")
+	cmd.Flags().BoolVar(&listHasBlocking, "has-blocking", false, "Filter beans that are blocking others")
+	cmd.Flags().BoolVar(&listNoBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
+	cmd.Flags().BoolVar(&listIsBlocked, "is-blocked", false, "Filter beans that are blocked by others")
+	cmd.Flags().BoolVar(&listReady, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)")
+	cmd.Flags().StringVar(&listSort, "sort", "", "Sort by: created, updated, due, status, priority, id (default: status, priority, type, title)")
+	cmd.Flags().StringVar(&listWhere, "where", "", "Filter by a query expression, e.g. \"type = bug AND priority >= high\" (see Query Expressions)")
+	cmd.Flags().StringVar(&listView, "view", "", "Use a named view from .beans.yml (its filter, sort and columns)")
+}
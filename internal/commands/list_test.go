@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
 	"github.com/hmans/beans/pkg/config"
 )
 
@@ -22,7 +24,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "a1"},
 			{ID: "b2"},
 		}
-		sortBeans(beans, "id", testCfg)
+		beangraph.SortBeans(beans, "id", testCfg)
 
 		if beans[0].ID != "a1" || beans[1].ID != "b2" || beans[2].ID != "c3" {
 			t.Errorf("sort by id: got [%s, %s, %s], want [a1, b2, c3]",
@@ -36,7 +38,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "new", CreatedAt: &now},
 			{ID: "mid", CreatedAt: &earlier},
 		}
-		sortBeans(beans, "created", testCfg)
+		beangraph.SortBeans(beans, "created", testCfg)
 
 		// Should be newest first
 		if beans[0].ID != "new" || beans[1].ID != "mid" || beans[2].ID != "old" {
@@ -51,7 +53,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "has", CreatedAt: &now},
 			{ID: "nil2", CreatedAt: nil},
 		}
-		sortBeans(beans, "created", testCfg)
+		beangraph.SortBeans(beans, "created", testCfg)
 
 		// Non-nil should come first, then nil sorted by ID
 		if beans[0].ID != "has" {
@@ -65,7 +67,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "new", UpdatedAt: &now},
 			{ID: "mid", UpdatedAt: &earlier},
 		}
-		sortBeans(beans, "updated", testCfg)
+		beangraph.SortBeans(beans, "updated", testCfg)
 
 		// Should be newest first
 		if beans[0].ID != "new" || beans[1].ID != "mid" || beans[2].ID != "old" {
@@ -74,6 +76,21 @@ func TestSortBeans(t *testing.T) {
 		}
 	})
 
+	t.Run("sort by due", func(t *testing.T) {
+		beans := []*bean.Bean{
+			{ID: "none"},
+			{ID: "later", DueAt: &now},
+			{ID: "soon", DueAt: &evenEarlier},
+		}
+		beangraph.SortBeans(beans, "due", testCfg)
+
+		// Soonest due first, beans without a due date last
+		if beans[0].ID != "soon" || beans[1].ID != "later" || beans[2].ID != "none" {
+			t.Errorf("sort by due: got [%s, %s, %s], want [soon, later, none]",
+				beans[0].ID, beans[1].ID, beans[2].ID)
+		}
+	})
+
 	t.Run("sort by status", func(t *testing.T) {
 		beans := []*bean.Bean{
 			{ID: "c1", Status: "completed"},
@@ -81,7 +98,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "i1", Status: "in-progress"},
 			{ID: "t2", Status: "todo"},
 		}
-		sortBeans(beans, "status", testCfg)
+		beangraph.SortBeans(beans, "status", testCfg)
 
 		// Should be ordered by status config order (in-progress, todo, draft, completed, scrapped), then by ID within same status
 		expected := []string{"i1", "t1", "t2", "c1"}
@@ -100,7 +117,7 @@ func TestSortBeans(t *testing.T) {
 			{ID: "completed-task", Status: "completed", Type: "task"},
 			{ID: "todo-bug", Status: "todo", Type: "bug"},
 		}
-		sortBeans(beans, "", testCfg)
+		beangraph.SortBeans(beans, "", testCfg)
 
 		// Should be: non-archive first (sorted by type order from DefaultTypes: milestone, epic, bug, feature, task),
 		// then archive (sorted by type)
@@ -141,6 +158,19 @@ func TestListReadyFlagMutualExclusion(t *testing.T) {
 	}
 }
 
+func TestParseFieldFilters(t *testing.T) {
+	filters := parseFieldFilters([]string{"severity=high", "points", "severity=low"})
+	if len(filters) != 2 {
+		t.Fatalf("parseFieldFilters() returned %d filters, want 2", len(filters))
+	}
+	if filters[0].Name != "severity" || strings.Join(filters[0].Values, ",") != "high,low" {
+		t.Errorf("filters[0] = %+v, want severity with values high,low", filters[0])
+	}
+	if filters[1].Name != "points" || filters[1].Values != nil {
+		t.Errorf("filters[1] = %+v, want points with no values", filters[1])
+	}
+}
+
 func TestTruncate(t *testing.T) {
 	tests := []struct {
 		name   string
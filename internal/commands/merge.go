@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/spf13/cobra"
+)
+
+var mergeJSON bool
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge <duplicate-id> <into-id>",
+	Short: "Merge a duplicate bean into another",
+	Long: `Merges a duplicate bean into the bean that should be kept:
+
+- The duplicate's tags and blocking relationships move to the target
+- The duplicate's body is appended to the target's, below a note naming it
+- Links from other beans to the duplicate are rewritten to the target
+- The duplicate is marked scrapped with a duplicates link to the target
+
+Links that would create a cycle after the merge are dropped and reported.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &beangraph.CoreResolver{Core: core}
+
+		for _, id := range args {
+			if b, err := resolver.Bean(ctx, id); err != nil || b == nil {
+				return cmdError(mergeJSON, output.ErrNotFound, "bean not found: %s", id)
+			}
+		}
+
+		result, err := resolver.MergeBean(ctx, args[0], args[1])
+		if err != nil {
+			return cmdError(mergeJSON, output.ErrValidation, "%s", err)
+		}
+
+		var warnings []string
+		for _, link := range result.Skipped {
+			warnings = append(warnings, "dropped link (would create cycle): "+link)
+		}
+
+		if mergeJSON {
+			msg := fmt.Sprintf("Merged %s into %s (%d link(s) rewritten)", result.Duplicate.ID, result.Into.ID, result.LinksRewritten)
+			return output.SuccessWithWarnings(result.Into, msg, warnings)
+		}
+
+		fmt.Println(ui.Success.Render("Merged ") + ui.ID.Render(result.Duplicate.ID) +
+			ui.Success.Render(" into ") + ui.ID.Render(result.Into.ID) + " " + ui.Muted.Render(result.Into.Path))
+		if result.LinksRewritten > 0 {
+			fmt.Println(ui.Muted.Render(fmt.Sprintf("Rewrote %d incoming link(s)", result.LinksRewritten)))
+		}
+		for _, w := range warnings {
+			fmt.Println(ui.Warning.Render("Warning: ") + w)
+		}
+		return nil
+	},
+}
+
+func RegisterMergeCmd(root *cobra.Command) {
+	mergeCmd.Flags().BoolVar(&mergeJSON, "json", false, "Output as JSON")
+	root.AddCommand(mergeCmd)
+}
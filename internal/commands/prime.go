@@ -2,6 +2,7 @@ package commands
 
 import (
 	_ "embed"
+	"fmt"
 	"os"
 	"text/template"
 
@@ -17,7 +18,10 @@ type promptData struct {
 	GraphQLSchema string
 	Types         []config.TypeConfig
 	Statuses      []config.StatusConfig
+	Transitions   map[string][]string
 	Priorities    []config.PriorityConfig
+	Fields        []config.FieldConfig
+	Views         []config.ViewConfig
 }
 
 var primeCmd = &cobra.Command{
@@ -28,18 +32,29 @@ var primeCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// If no explicit path given, check if a beans project exists by searching
 		// upward for a .beans.yml config file
+		configFile := configPath
 		if beansPath == "" && configPath == "" {
 			cwd, err := os.Getwd()
 			if err != nil {
 				return nil // Silently exit on error
 			}
-			configFile, err := config.FindConfig(cwd)
+			configFile, err = config.FindConfig(cwd)
 			if err != nil || configFile == "" {
 				// No config file found - silently exit
 				return nil
 			}
 		}
 
+		// Load the project config so custom types, statuses, transitions, fields and views are included
+		primeCfg := config.Default()
+		if configFile != "" {
+			loaded, err := config.Load(configFile)
+			if err != nil {
+				return fmt.Errorf("loading config from %s: %w", configFile, err)
+			}
+			primeCfg = loaded
+		}
+
 		tmpl, err := template.New("prompt").Parse(agentPromptTemplate)
 		if err != nil {
 			return err
@@ -47,9 +62,12 @@ var primeCmd = &cobra.Command{
 
 		data := promptData{
 			GraphQLSchema: GetGraphQLSchema(),
-			Types:         config.DefaultTypes,
-			Statuses:      config.DefaultStatuses,
+			Types:         primeCfg.Types(),
+			Statuses:      primeCfg.Statuses(),
+			Transitions:   primeCfg.Transitions,
 			Priorities:    config.DefaultPriorities,
+			Fields:        primeCfg.Fields,
+			Views:         primeCfg.Views,
 		}
 
 		return tmpl.Execute(os.Stdout, data)
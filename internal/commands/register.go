@@ -11,14 +11,22 @@ import (
 func RegisterCoreCommands(root *cobra.Command) {
 	RegisterArchiveCmd(root)
 	RegisterCheckCmd(root)
+	RegisterCommentCmd(root)
 	RegisterCreateCmd(root)
 	RegisterDeleteCmd(root)
+	RegisterExportCmd(root)
 	RegisterGraphqlCmd(root)
+	RegisterHistoryCmd(root)
+	RegisterImportCmd(root)
 	RegisterInitCmd(root)
 	RegisterListCmd(root)
+	RegisterMergeCmd(root)
 	RegisterPrimeCmd(root)
 	RegisterRoadmapCmd(root)
 	RegisterShowCmd(root)
+	RegisterSimilarCmd(root)
+	RegisterSyncCmd(root)
+	RegisterUndoCmd(root)
 	RegisterUpdateCmd(root)
 	RegisterVersionCmd(root)
 
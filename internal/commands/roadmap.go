@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph"
@@ -43,15 +44,25 @@ type unscheduledGroup struct {
 
 // milestoneGroup represents a milestone and its contents.
 type milestoneGroup struct {
-	Milestone *bean.Bean   `json:"milestone"`
-	Epics     []epicGroup  `json:"epics,omitempty"`
-	Other     []*bean.Bean `json:"other,omitempty"`
+	Milestone *bean.Bean       `json:"milestone"`
+	Epics     []epicGroup      `json:"epics,omitempty"`
+	Other     []*bean.Bean     `json:"other,omitempty"`
+	Progress  estimateProgress `json:"progress"`
+	// Overdue is set when the milestone or any open descendant is past its due date.
+	Overdue bool `json:"overdue,omitempty"`
+}
+
+// estimateProgress is the estimate rollup over a bean's descendants.
+type estimateProgress struct {
+	Done  float64 `json:"done"`
+	Total float64 `json:"total"`
 }
 
 // epicGroup represents an epic and its child items.
 type epicGroup struct {
-	Epic  *bean.Bean   `json:"epic"`
-	Items []*bean.Bean `json:"items,omitempty"`
+	Epic     *bean.Bean       `json:"epic"`
+	Items    []*bean.Bean     `json:"items,omitempty"`
+	Progress estimateProgress `json:"progress"`
 }
 
 
@@ -126,9 +137,12 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 	sortByStatusThenCreated(milestones, cfg)
 
 	// Build milestone groups
+	now := time.Now()
 	var milestoneGroups []milestoneGroup
 	for _, m := range milestones {
 		group := buildMilestoneGroup(m, children, includeDone)
+		group.Overdue = hasOverdue(m, children, now)
+		group.Progress = progressOf(m, children)
 		// Only include milestones that have visible content
 		if len(group.Epics) > 0 || len(group.Other) > 0 {
 			milestoneGroups = append(milestoneGroups, group)
@@ -143,7 +157,7 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 		for _, child := range children[m.ID] {
 			underMilestone[child.ID] = true
 			// Also mark children of epics under this milestone
-			if child.Type == "epic" {
+			if isEpicType(child.Type) {
 				for _, epicChild := range children[child.ID] {
 					underMilestone[epicChild.ID] = true
 				}
@@ -154,7 +168,7 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 	// Find unscheduled epics (epics not under a milestone)
 	var unscheduledEpics []epicGroup
 	for _, b := range allBeans {
-		if b.Type != "epic" {
+		if !isEpicType(b.Type) {
 			continue
 		}
 		if underMilestone[b.ID] {
@@ -164,7 +178,7 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 		epicItems := filterChildren(children[b.ID], includeDone)
 		if len(epicItems) > 0 {
 			sortByTypeThenStatus(epicItems, cfg)
-			unscheduledEpics = append(unscheduledEpics, epicGroup{Epic: b, Items: epicItems})
+			unscheduledEpics = append(unscheduledEpics, epicGroup{Epic: b, Items: epicItems, Progress: progressOf(b, children)})
 		}
 	}
 
@@ -177,7 +191,7 @@ func buildRoadmap(allBeans []*bean.Bean, includeDone bool, statusFilter, noStatu
 	var orphanItems []*bean.Bean
 	for _, b := range allBeans {
 		// Skip milestones and epics
-		if b.Type == "milestone" || b.Type == "epic" {
+		if b.Type == "milestone" || isEpicType(b.Type) {
 			continue
 		}
 		// Skip if already under a milestone
@@ -224,7 +238,7 @@ func buildMilestoneGroup(m *bean.Bean, children map[string][]*bean.Bean, include
 	var epics []*bean.Bean
 
 	for _, child := range directChildren {
-		if child.Type == "epic" {
+		if isEpicType(child.Type) {
 			epics = append(epics, child)
 		}
 	}
@@ -235,7 +249,7 @@ func buildMilestoneGroup(m *bean.Bean, children map[string][]*bean.Bean, include
 		// Only include epics that have visible children
 		if len(epicItems) > 0 {
 			sortByTypeThenStatus(epicItems, cfg)
-			group.Epics = append(group.Epics, epicGroup{Epic: epic, Items: epicItems})
+			group.Epics = append(group.Epics, epicGroup{Epic: epic, Items: epicItems, Progress: progressOf(epic, children)})
 		}
 	}
 
@@ -243,7 +257,7 @@ func buildMilestoneGroup(m *bean.Bean, children map[string][]*bean.Bean, include
 	// (With single parent enforcement, items can't be both under an epic and directly under the milestone)
 	var other []*bean.Bean
 	for _, child := range directChildren {
-		if child.Type == "epic" {
+		if isEpicType(child.Type) {
 			continue
 		}
 		if includeDone || !cfg.IsArchiveStatus(child.Status) {
@@ -263,6 +277,66 @@ func buildMilestoneGroup(m *bean.Bean, children map[string][]*bean.Bean, include
 	return group
 }
 
+// hasOverdue reports whether b or any of its descendants is overdue.
+func hasOverdue(b *bean.Bean, children map[string][]*bean.Bean, now time.Time) bool {
+	if isOverdue(b, now) {
+		return true
+	}
+	for _, child := range children[b.ID] {
+		if hasOverdue(child, children, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// progressOf sums the estimates of all descendants of b.
+func progressOf(b *bean.Bean, children map[string][]*bean.Bean) estimateProgress {
+	var descendants []*bean.Bean
+	var walk func(id string)
+	walk = func(id string) {
+		for _, child := range children[id] {
+			descendants = append(descendants, child)
+			walk(child.ID)
+		}
+	}
+	walk(b.ID)
+	done, total := bean.SumEstimates(descendants, cfg.IsArchiveStatus)
+	return estimateProgress{Done: done, Total: total}
+}
+
+// progressLabel renders progress as "done/total unit", or "" without estimates.
+func progressLabel(p estimateProgress) string {
+	if p.Total == 0 {
+		return ""
+	}
+	return bean.FormatEstimate(p.Done) + "/" + bean.FormatEstimate(p.Total) + " " + cfg.GetEstimateUnit()
+}
+
+// isOverdue reports whether b is past its due date and not yet done.
+func isOverdue(b *bean.Bean, now time.Time) bool {
+	return b.DueAt != nil && b.DueAt.Before(now) && !cfg.IsArchiveStatus(b.Status)
+}
+
+// dueDate returns the bean's formatted due date, or "" if it has none.
+func dueDate(b *bean.Bean) string {
+	if b.DueAt == nil {
+		return ""
+	}
+	return bean.FormatDate(*b.DueAt)
+}
+
+// isEpicType reports whether beans of the given type are grouped like epics
+// in the roadmap: epics themselves, plus any configured type whose parent
+// rules only allow a milestone as parent (e.g. a custom "initiative" type).
+func isEpicType(typeName string) bool {
+	if typeName == "epic" {
+		return true
+	}
+	parents := cfg.ValidParentTypes(typeName)
+	return len(parents) > 0 && !slices.ContainsFunc(parents, func(p string) bool { return p != "milestone" })
+}
+
 // filterChildren filters children based on done status.
 func filterChildren(children []*bean.Bean, includeDone bool) []*bean.Bean {
 	if includeDone {
@@ -342,6 +416,12 @@ func renderRoadmapMarkdown(data *roadmapData, links bool, linkPrefix string) str
 		template.New("roadmap").Funcs(template.FuncMap{
 			"firstParagraph": firstParagraph,
 			"typeBadge":      typeBadge,
+			"typeLabel":      typeLabel,
+			"dueDate":        dueDate,
+			"progress":       progressLabel,
+			"isOverdue": func(b *bean.Bean) bool {
+				return isOverdue(b, time.Now())
+			},
 			"beanRef": func(b *bean.Bean) string {
 				return renderBeanRef(b, links, linkPrefix)
 			},
@@ -385,11 +465,24 @@ func typeBadge(b *bean.Bean) string {
 	}
 	color := colors[b.Type]
 	if color == "" {
+		// Custom types use their configured color name
 		color = "gray"
+		if t := cfg.GetType(b.Type); t != nil && t.Color != "" {
+			color = strings.TrimPrefix(t.Color, "#")
+		}
 	}
 	return fmt.Sprintf("![%s](https://img.shields.io/badge/%s-%s?style=flat-square)", b.Type, b.Type, color)
 }
 
+// typeLabel returns the bean's type name with its first letter capitalized,
+// for use in headings (e.g. "Epic").
+func typeLabel(b *bean.Bean) string {
+	if b.Type == "" {
+		return ""
+	}
+	return strings.ToUpper(b.Type[:1]) + b.Type[1:]
+}
+
 // defaultLinkPrefix returns the relative path from cwd to the .beans directory.
 func defaultLinkPrefix() string {
 	cwd, err := os.Getwd()
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -254,3 +255,125 @@ func TestStatusFiltering(t *testing.T) {
 		}
 	})
 }
+
+func TestBuildRoadmapCustomTypes(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+
+	milestoneOnly := []string{"milestone"}
+	initiativeParents := []string{"milestone", "epic", "initiative"}
+	cfg = config.Default()
+	cfg.CustomTypes = []config.CustomTypeConfig{
+		{Name: "initiative", Color: "orange", Parents: &milestoneOnly},
+		{Name: "spike", Color: "pink"},
+		{Name: "task", Parents: &initiativeParents},
+	}
+
+	now := time.Now()
+	beans := []*bean.Bean{
+		{ID: "m1", Type: "milestone", Title: "v1.0", Status: "todo", CreatedAt: &now},
+		{ID: "i1", Type: "initiative", Title: "Onboarding", Status: "todo", Parent: "m1"},
+		{ID: "t1", Type: "task", Title: "Signup", Status: "todo", Parent: "i1"},
+		{ID: "s1", Type: "spike", Title: "Research", Status: "todo", Parent: "m1"},
+	}
+
+	result := buildRoadmap(beans, false, nil, nil)
+	if len(result.Milestones) != 1 {
+		t.Fatalf("got %d milestones, want 1", len(result.Milestones))
+	}
+	group := result.Milestones[0]
+	if len(group.Epics) != 1 || group.Epics[0].Epic.ID != "i1" {
+		t.Fatalf("epic groups = %+v, want the initiative grouped like an epic", group.Epics)
+	}
+	if len(group.Epics[0].Items) != 1 || group.Epics[0].Items[0].ID != "t1" {
+		t.Errorf("initiative items = %+v, want t1", group.Epics[0].Items)
+	}
+	if len(group.Other) != 1 || group.Other[0].ID != "s1" {
+		t.Errorf("other = %+v, want the spike listed directly", group.Other)
+	}
+
+	md := renderRoadmapMarkdown(result, false, "")
+	if !strings.Contains(md, "### Initiative: Onboarding") {
+		t.Errorf("markdown missing initiative heading:\n%s", md)
+	}
+	if !strings.Contains(md, "spike-pink") {
+		t.Errorf("markdown missing configured spike badge color:\n%s", md)
+	}
+}
+
+func TestBuildRoadmapOverdue(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	now := time.Now()
+	past := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+	future := now.Add(30 * 24 * time.Hour)
+	beans := []*bean.Bean{
+		{ID: "m1", Type: "milestone", Title: "v1.0", Status: "todo", CreatedAt: &now, DueAt: &future},
+		{ID: "e1", Type: "epic", Title: "Auth", Status: "todo", Parent: "m1"},
+		{ID: "t1", Type: "task", Title: "Login", Status: "todo", Parent: "e1", DueAt: &past},
+		{ID: "m2", Type: "milestone", Title: "v2.0", Status: "todo", CreatedAt: &now},
+		{ID: "t2", Type: "task", Title: "Shipped", Status: "completed", Parent: "m2", DueAt: &past},
+		{ID: "t3", Type: "task", Title: "Later", Status: "todo", Parent: "m2"},
+	}
+
+	result := buildRoadmap(beans, false, nil, nil)
+	if len(result.Milestones) != 2 {
+		t.Fatalf("got %d milestones, want 2", len(result.Milestones))
+	}
+	overdue := map[string]bool{}
+	for _, g := range result.Milestones {
+		overdue[g.Milestone.ID] = g.Overdue
+	}
+	if !overdue["m1"] {
+		t.Error("m1 should be flagged overdue via its grandchild t1")
+	}
+	if overdue["m2"] {
+		t.Error("m2 should not be flagged: its only past-due child is completed")
+	}
+
+	md := renderRoadmapMarkdown(result, false, "")
+	if !strings.Contains(md, "## Milestone: v1.0 (m1) · due "+bean.FormatDate(future)+" ⚠️ overdue") {
+		t.Errorf("markdown missing overdue milestone heading:\n%s", md)
+	}
+	if !strings.Contains(md, "Login (t1) · due 2020-01-15 **(overdue)**") {
+		t.Errorf("markdown missing overdue item due date:\n%s", md)
+	}
+}
+
+func TestBuildRoadmapProgress(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	now := time.Now()
+	beans := []*bean.Bean{
+		{ID: "m1", Type: "milestone", Title: "v1.0", Status: "todo", CreatedAt: &now},
+		{ID: "e1", Type: "epic", Title: "Auth", Status: "todo", Parent: "m1"},
+		{ID: "t1", Type: "task", Title: "Login", Status: "completed", Parent: "e1", Estimate: 3},
+		{ID: "t2", Type: "task", Title: "Logout", Status: "todo", Parent: "e1", Estimate: 2},
+		{ID: "t3", Type: "task", Title: "SSO", Status: "scrapped", Parent: "e1", Estimate: 8},
+		{ID: "t4", Type: "task", Title: "Docs", Status: "todo", Parent: "m1", Estimate: 0.5},
+	}
+
+	result := buildRoadmap(beans, false, nil, nil)
+	if len(result.Milestones) != 1 {
+		t.Fatalf("got %d milestones, want 1", len(result.Milestones))
+	}
+	group := result.Milestones[0]
+	if group.Progress != (estimateProgress{Done: 3, Total: 5.5}) {
+		t.Errorf("milestone progress = %+v, want 3/5.5", group.Progress)
+	}
+	if len(group.Epics) != 1 || group.Epics[0].Progress != (estimateProgress{Done: 3, Total: 5}) {
+		t.Errorf("epic groups = %+v, want Auth with 3/5", group.Epics)
+	}
+
+	md := renderRoadmapMarkdown(result, false, "")
+	if !strings.Contains(md, "## Milestone: v1.0 (m1) · 3/5.5 points done") {
+		t.Errorf("markdown missing milestone progress:\n%s", md)
+	}
+	if !strings.Contains(md, "### Epic: Auth (e1) · 3/5 points done") {
+		t.Errorf("markdown missing epic progress:\n%s", md)
+	}
+}
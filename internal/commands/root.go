@@ -96,9 +96,14 @@ func resolveBeansPath(flagPath string, c *config.Config) (string, error) {
 	return root, nil
 }
 
-// Execute runs the given root command and exits on error.
+// Execute runs the given root command and exits on error. The core is closed
+// afterwards so that a persistent search index is written out.
 func Execute(rootCmd *cobra.Command) {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	if core != nil {
+		core.Close()
+	}
+	if err != nil {
 		os.Exit(1)
 	}
 }
@@ -277,9 +277,9 @@ func runServer(port int, origins []string) error {
 		core.SetOnWorktreeBeansChanged(wtManager.Notify)
 	}
 
-	// Detect git forge (GitHub, GitLab, etc.) for PR integration
+	// Detect git forge (GitHub, GitLab, Gitea, etc.) for PR integration
 	projectRoot := filepath.Dir(core.Root())
-	forgeProvider := forge.Detect(projectRoot)
+	forgeProvider := forge.Detect(projectRoot, cfg.Forge.GiteaHosts)
 	if forgeProvider != nil {
 		fmt.Printf("[beans] detected forge: %s (using %s CLI)\n", forgeProvider.Name(), forgeProvider.CLIName())
 	}
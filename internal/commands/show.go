@@ -3,7 +3,9 @@ package commands
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
@@ -149,6 +151,32 @@ func showStyledBean(b *bean.Bean) {
 		header.WriteString("  ")
 		header.WriteString(ui.Muted.Render(strings.Join(b.Tags, ", ")))
 	}
+	if len(b.Assignees) > 0 {
+		header.WriteString("  ")
+		header.WriteString(ui.RenderAssignees(b.Assignees))
+	}
+	if b.StartAt != nil {
+		header.WriteString("  ")
+		header.WriteString(ui.Muted.Render("start " + bean.FormatDate(*b.StartAt)))
+	}
+	if b.DueAt != nil {
+		header.WriteString("  ")
+		if core.IsOverdue(b, time.Now()) {
+			header.WriteString(ui.Danger.Render("overdue " + bean.FormatDate(*b.DueAt)))
+		} else {
+			header.WriteString(ui.Muted.Render("due " + bean.FormatDate(*b.DueAt)))
+		}
+	}
+	if b.Estimate > 0 {
+		header.WriteString("  ")
+		header.WriteString(ui.Muted.Render("estimate " + bean.FormatEstimate(b.Estimate) + " " + cfg.GetEstimateUnit()))
+	}
+	resolver := &beangraph.CoreResolver{Core: core}
+	if total, _ := resolver.BeanTotalEstimate(context.Background(), b); total > 0 {
+		done, _ := resolver.BeanCompletedEstimate(context.Background(), b)
+		header.WriteString("  ")
+		header.WriteString(ui.Muted.Render("done " + bean.FormatEstimate(done) + "/" + bean.FormatEstimate(total) + " " + cfg.GetEstimateUnit()))
+	}
 	if b.CreatedAt != nil {
 		header.WriteString("  ")
 		header.WriteString(ui.Muted.Render("created "+b.CreatedAt.Format("2006-01-02 15:04 UTC")))
@@ -160,8 +188,16 @@ func showStyledBean(b *bean.Bean) {
 	header.WriteString("\n")
 	header.WriteString(ui.Title.Render(b.Title))
 
+	// Display custom fields
+	if len(b.Fields) > 0 {
+		header.WriteString("\n")
+		header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
+		header.WriteString("\n")
+		header.WriteString(formatFields(b))
+	}
+
 	// Display relationships
-	if b.Parent != "" || len(b.Blocking) > 0 {
+	if b.Parent != "" || len(b.Blocking) > 0 || len(b.Links) > 0 {
 		header.WriteString("\n")
 		header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
 		header.WriteString("\n")
@@ -196,9 +232,16 @@ func showStyledBean(b *bean.Bean) {
 
 		fmt.Print(rendered)
 	}
+
+	if len(b.Comments) > 0 {
+		fmt.Println(ui.Muted.Render(strings.Repeat("─", 50)))
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("%d comment(s)", len(b.Comments))))
+		fmt.Println()
+		fmt.Print(formatComments(b.Comments))
+	}
 }
 
-// formatRelationships formats parent and blocks for display.
+// formatRelationships formats parent, blocks and typed links for display.
 func formatRelationships(b *bean.Bean) string {
 	var parts []string
 
@@ -215,6 +258,30 @@ func formatRelationships(b *bean.Bean) string {
 			ui.Muted.Render("blocking:"),
 			ui.ID.Render(target)))
 	}
+
+	// Display typed links
+	for _, linkType := range b.LinkTypes() {
+		for _, target := range b.Links[linkType] {
+			parts = append(parts, fmt.Sprintf("%s %s",
+				ui.Muted.Render(linkType+":"),
+				ui.ID.Render(target)))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// formatFields lists a bean's custom fields, sorted by name.
+func formatFields(b *bean.Bean) string {
+	names := make([]string, 0, len(b.Fields))
+	for name := range b.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s %s", ui.Muted.Render(name+":"), b.Fields[name])
+	}
 	return strings.Join(parts, "\n")
 }
 
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/spf13/cobra"
+)
+
+var (
+	similarJSON  bool
+	similarLimit int
+)
+
+var similarCmd = &cobra.Command{
+	Use:   "similar <id>",
+	Short: "Find beans similar to a bean",
+	Long: `Lists the beans whose title, tags and body are most similar to those of a bean,
+best first, with their similarity score (up to 1). Archived beans are included.
+
+Unlike full-text search, this also finds beans that describe the same thing in
+different words (e.g. "login broken" and "auth failure"), which makes it useful
+for spotting duplicates.
+
+Embeddings are computed offline from the beans themselves (latent semantic
+analysis). To use another backend, such as a local model, set
+search.embedding_command in .beans.yml to a command that reads a JSON array of
+texts on stdin and writes a JSON array of vectors to stdout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		similar, err := core.Similar(args[0], similarLimit)
+		if errors.Is(err, beancore.ErrNotFound) {
+			return cmdError(similarJSON, output.ErrNotFound, "bean not found: %s", args[0])
+		}
+		if err != nil {
+			return cmdError(similarJSON, output.ErrFileError, "%s", err)
+		}
+
+		if similarJSON {
+			if similar == nil {
+				similar = []beancore.SimilarBean{}
+			}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(similar)
+		}
+
+		if len(similar) == 0 {
+			fmt.Println(ui.Muted.Render("No similar beans found."))
+			return nil
+		}
+		for _, s := range similar {
+			fmt.Printf("%s  %s  %s  %s\n",
+				ui.Muted.Render(fmt.Sprintf("%.2f", s.Score)),
+				ui.ID.Render(s.Bean.ID),
+				ui.Muted.Render(s.Bean.Status),
+				s.Bean.Title)
+		}
+		return nil
+	},
+}
+
+func RegisterSimilarCmd(root *cobra.Command) {
+	similarCmd.Flags().BoolVar(&similarJSON, "json", false, "Output as JSON")
+	similarCmd.Flags().IntVarP(&similarLimit, "limit", "n", beancore.DefaultSimilarLimit, "Maximum number of beans to show")
+	root.AddCommand(similarCmd)
+}
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/hmans/beans/internal/issues"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/forge"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncDryRun bool
+	syncJSON   bool
+)
+
+// syncOwnFlags are the sync flags that don't select beans.
+var syncOwnFlags = []string{"dry-run", "json"}
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync beans with issues on a git forge",
+}
+
+var syncGithubCmd = &cobra.Command{
+	Use:   "github [<id>...]",
+	Short: "Mirror beans to GitHub issues and pull back their changes",
+	Long: `Mirrors beans to issues in the GitHub repository of the project's origin remote,
+using the gh CLI.
+
+Beans are selected by ID, or with the same filter flags as beans list. Without
+either, the beans already linked to an issue are synced. A selected bean
+without an issue gets a new one, and the bean records the issue's number (as
+issue) and URL (as source). Beans imported with beans import github are
+linked by their source.
+
+When the title, body or open/closed state of a bean and its issue differ, the
+one updated last wins: a newer bean is pushed to the issue, a newer issue is
+pulled into the bean. Beans in an archive status (completed, scrapped) close
+their issues, as not planned if scrapped. New comments on an issue are added
+to its bean's comments.`,
+	Example: `  beans sync github                 # sync the beans linked to issues
+  beans sync github beans-abc1      # mirror a bean to a new issue
+  beans sync github -t bug --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := exec.LookPath("gh"); err != nil {
+			return cmdError(syncJSON, output.ErrValidation, "the gh CLI is required (https://cli.github.com)")
+		}
+
+		var selected []*bean.Bean
+		for _, id := range args {
+			b, err := core.Get(id)
+			if err != nil {
+				return cmdError(syncJSON, output.ErrNotFound, "bean not found: %s", id)
+			}
+			selected = append(selected, b)
+		}
+		if len(args) == 0 {
+			var err error
+			if selected, err = selectSyncBeans(cmd); err != nil {
+				return cmdError(syncJSON, output.ErrValidation, "%s", err)
+			}
+		}
+
+		opts := issues.SyncOptions{RepoDir: filepath.Dir(core.Root()), DryRun: syncDryRun}
+		result, err := issues.Sync(context.Background(), core, &forge.GitHub{}, selected, opts)
+		if err != nil {
+			return cmdError(syncJSON, output.ErrValidation, "%s", err)
+		}
+
+		if syncJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(result)
+		}
+
+		for _, b := range result.Created {
+			printSynced("created", b)
+		}
+		for _, b := range result.Pushed {
+			printSynced("pushed", b)
+		}
+		for _, b := range result.Pulled {
+			printSynced("pulled", b)
+		}
+		summary := fmt.Sprintf("%d created, %d pushed, %d pulled, %d unchanged",
+			len(result.Created), len(result.Pushed), len(result.Pulled), len(result.Unchanged))
+		if syncDryRun {
+			summary += " (dry run, nothing written)"
+		}
+		fmt.Println(ui.Muted.Render(summary))
+		return nil
+	},
+}
+
+// selectSyncBeans returns the beans matching the filter flags, or the beans
+// linked to an issue if no filter flag is set.
+func selectSyncBeans(cmd *cobra.Command) ([]*bean.Bean, error) {
+	// Any flag set besides sync's own is a filter flag
+	own := 0
+	for _, name := range syncOwnFlags {
+		if cmd.Flags().Changed(name) {
+			own++
+		}
+	}
+	filtered := cmd.Flags().NFlag() > own
+	resolver := &beangraph.CoreResolver{Core: core}
+	beans, _, err := queryListBeans(resolver)
+	if err != nil || filtered {
+		return beans, err
+	}
+
+	var linked []*bean.Bean
+	for _, b := range beans {
+		if b.Issue != 0 || issues.IssueNumber(b.Source) != 0 {
+			linked = append(linked, b)
+		}
+	}
+	return linked, nil
+}
+
+// printSynced prints one line for a bean a sync created an issue for, pushed
+// or pulled.
+func printSynced(action string, b *bean.Bean) {
+	ref := b.Source
+	if b.Issue != 0 && ref == "" {
+		ref = fmt.Sprintf("#%d", b.Issue)
+	}
+	fmt.Println(ui.Success.Render(fmt.Sprintf("%-8s", action)) + " " + ui.ID.Render(b.ID) + "  " + b.Title + "  " + ui.Muted.Render(ref))
+}
+
+func RegisterSyncCmd(root *cobra.Command) {
+	syncGithubCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Show what would be synced without changing beans or issues")
+	syncGithubCmd.Flags().BoolVar(&syncJSON, "json", false, "Output as JSON")
+	addListFilterFlags(syncGithubCmd)
+	syncCmd.AddCommand(syncGithubCmd)
+	root.AddCommand(syncCmd)
+}
@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/spf13/cobra"
+)
+
+var (
+	undoJSON bool
+	undoList bool
+	redoJSON bool
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo [n]",
+	Short: "Revert the last change(s) made through beans",
+	Long: `Reverts the last n operations (default 1) recorded in the local journal
+(.beans/.journal.json), newest first. Every create, update, delete, archive and
+merge is journaled with the full file content before and after, so mistakes can
+be reverted even if nothing has been committed to git yet. Operations that
+changed several beans at once (batches, bulk updates, merges) are reverted as one.
+
+An operation is not reverted if one of its files was changed since by something
+other than beans, e.g. a manual edit. Use --list to show the journal.`,
+	Example: `  beans undo        # Revert the last operation
+  beans undo 3      # Revert the last three operations
+  beans undo --list # Show recent operations`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if undoList {
+			return listJournal()
+		}
+		return replayJournal(args, undoJSON, true)
+	},
+}
+
+var redoCmd = &cobra.Command{
+	Use:   "redo [n]",
+	Short: "Re-apply the last undone change(s)",
+	Long: `Re-applies the last n operations (default 1) reverted by 'beans undo', oldest
+first. Making any other change after an undo discards the undone operations.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return replayJournal(args, redoJSON, false)
+	},
+}
+
+// replayJournal runs undo (or redo) for the count given in args.
+func replayJournal(args []string, jsonMode, undo bool) error {
+	n := 1
+	if len(args) == 1 {
+		var err error
+		if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+			return cmdError(jsonMode, output.ErrValidation, "invalid count: %s", args[0])
+		}
+	}
+
+	action, verb := "undo", "Undid"
+	replay := core.Undo
+	if !undo {
+		action, verb = "redo", "Redid"
+		replay = core.Redo
+	}
+
+	entries, err := replay(n)
+	if errors.Is(err, beancore.ErrNothingToUndo) || errors.Is(err, beancore.ErrNothingToRedo) {
+		msg := fmt.Sprintf("Nothing to %s", action)
+		if jsonMode {
+			return output.SuccessMessage(msg)
+		}
+		fmt.Println(ui.Muted.Render(msg))
+		return nil
+	}
+
+	var conflict *beancore.JournalConflictError
+	if err != nil && !errors.As(err, &conflict) {
+		return cmdError(jsonMode, output.ErrFileError, "%s", err)
+	}
+	var warnings []string
+	if conflict != nil {
+		warnings = append(warnings, fmt.Sprintf("stopped after %d operation(s): %s", len(entries), conflict))
+	}
+
+	if jsonMode {
+		var beans []*bean.Bean
+		for _, e := range entries {
+			for _, id := range e.BeanIDs {
+				if b, err := core.Get(id); err == nil {
+					beans = append(beans, b)
+				}
+			}
+		}
+		resp := output.Response{
+			Success:  conflict == nil,
+			Beans:    beans,
+			Count:    len(entries),
+			Message:  fmt.Sprintf("%s %d operation(s)", verb, len(entries)),
+			Warnings: warnings,
+		}
+		if conflict != nil {
+			resp.Code = output.ErrConflict
+		}
+		return output.JSON(resp)
+	}
+
+	for _, e := range entries {
+		fmt.Println(ui.Success.Render(verb+" ") + formatJournalEntry(e))
+	}
+	if conflict != nil {
+		return fmt.Errorf("cannot %s further: %w", action, conflict)
+	}
+	return nil
+}
+
+// listJournal prints the journal, newest first, marking undone operations.
+func listJournal() error {
+	j, err := core.Journal()
+	if err != nil {
+		return cmdError(undoJSON, output.ErrFileError, "%s", err)
+	}
+
+	if undoJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(j)
+	}
+
+	if len(j.Entries) == 0 {
+		fmt.Println(ui.Muted.Render("No operations recorded yet."))
+		return nil
+	}
+	for i := len(j.Entries) - 1; i >= 0; i-- {
+		line := formatJournalEntry(j.Entries[i])
+		if i >= j.Position {
+			line += " " + ui.Muted.Render("(undone)")
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// formatJournalEntry renders an entry as "update beans-abc1  2025-06-01 14:03".
+func formatJournalEntry(e beancore.JournalEntry) string {
+	ids := make([]string, len(e.BeanIDs))
+	for i, id := range e.BeanIDs {
+		ids[i] = ui.ID.Render(id)
+	}
+	return ui.Bold.Render(e.Op) + " " + strings.Join(ids, ", ") + "  " +
+		ui.Muted.Render(e.Time.Local().Format("2006-01-02 15:04"))
+}
+
+func RegisterUndoCmd(root *cobra.Command) {
+	undoCmd.Flags().BoolVar(&undoJSON, "json", false, "Output as JSON")
+	undoCmd.Flags().BoolVar(&undoList, "list", false, "List recent operations instead of undoing")
+	redoCmd.Flags().BoolVar(&redoJSON, "json", false, "Output as JSON")
+	root.AddCommand(undoCmd)
+	root.AddCommand(redoCmd)
+}
@@ -32,9 +32,19 @@ var (
 	updateRemoveBlocking  []string
 	updateBlockedBy       []string
 	updateRemoveBlockedBy []string
+	updateLink            []string
+	updateRemoveLink      []string
 	updateTag             []string
 	updateRemoveTag       []string
+	updateField           []string
+	updateAssignee        []string
+	updateRemoveAssignee  []string
+	updateStart           string
+	updateDue             string
+	updateEstimate        float64
 	updateIfMatch         string
+	updateWhere           string
+	updateDryRun          bool
 	updateJSON            bool
 )
 
@@ -42,9 +52,29 @@ var updateCmd = &cobra.Command{
 	Use:     "update <id>",
 	Aliases: []string{"u"},
 	Short:   "Update a bean's properties",
-	Long:    `Updates one or more properties of an existing bean.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Updates one or more properties of an existing bean.
+
+To update every bean matching a filter instead, pass the filter as --where:
+either a query expression (see beans list --help) or the same flags as beans
+list. All matches are updated as one transaction: if any update fails, no bean
+is changed. Use --dry-run to preview the changes.
+
+  beans update --where "status = todo AND type = bug AND tags = auth" --parent <milestone-id>
+  beans update --where "--priority low --parent <epic-id>" -p deferred --dry-run`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("where") {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("where") {
+			return runBulkUpdate(cmd)
+		}
+		if updateDryRun {
+			return cmdError(updateJSON, output.ErrValidation, "--dry-run requires --where")
+		}
+
 		ctx := context.Background()
 		resolver := &beangraph.CoreResolver{Core: core}
 
@@ -102,7 +132,7 @@ var updateCmd = &cobra.Command{
 		// Require at least one change
 		if len(changes) == 0 {
 			return cmdError(updateJSON, output.ErrValidation,
-				"no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --tag, or their --remove-* variants)")
+				"no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --link, --tag, --assignee, --field, or their --remove-* variants)")
 		}
 
 		// Output result
@@ -123,6 +153,131 @@ var updateCmd = &cobra.Command{
 	},
 }
 
+// runBulkUpdate applies the update flags to every bean matching --where.
+func runBulkUpdate(cmd *cobra.Command) error {
+	ctx := context.Background()
+	resolver := &beangraph.CoreResolver{Core: core}
+
+	if updateIfMatch != "" {
+		return cmdError(updateJSON, output.ErrValidation, "--if-match cannot be combined with --where")
+	}
+
+	filter, err := parseWhereFilter(updateWhere)
+	if err != nil {
+		return cmdError(updateJSON, output.ErrValidation, "%s", err)
+	}
+
+	input, changes, err := buildUpdateInput(cmd, nil, "")
+	if err != nil {
+		return cmdError(updateJSON, output.ErrValidation, "%s", err)
+	}
+	if len(changes) == 0 {
+		return cmdError(updateJSON, output.ErrValidation,
+			"no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --link, --tag, --assignee, --field, or their --remove-* variants)")
+	}
+
+	beans, err := resolver.UpdateBeans(ctx, *filter, input, &updateDryRun)
+	if err != nil {
+		return mutationError(updateJSON, err)
+	}
+
+	if updateJSON {
+		if updateDryRun {
+			return output.JSON(output.Response{Success: true, Beans: beans, Count: len(beans), Message: "Dry run, no beans updated"})
+		}
+		return output.JSON(output.Response{Success: true, Beans: beans, Count: len(beans), Message: fmt.Sprintf("%d bean(s) updated", len(beans))})
+	}
+
+	if len(beans) == 0 {
+		fmt.Println(ui.Muted.Render("No beans match --where."))
+		return nil
+	}
+	if updateDryRun {
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("Dry run: would update %d bean(s)", len(beans))))
+		for _, b := range beans {
+			original, err := core.Get(b.ID)
+			if err != nil {
+				return cmdError(updateJSON, output.ErrNotFound, "%s", err)
+			}
+			fmt.Println(ui.ID.Render(b.ID) + " " + b.Title)
+			for _, c := range bean.Diff(original, b) {
+				fmt.Println("  " + formatFieldChange(c))
+			}
+		}
+		return nil
+	}
+	for _, b := range beans {
+		fmt.Println(ui.Success.Render("Updated ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
+	}
+	return nil
+}
+
+// parseWhereFilter parses a --where value into a filter. Values starting
+// with a dash are beans list filter flags such as "--status todo --tag auth";
+// anything else is a query expression.
+func parseWhereFilter(where string) (*model.BeanFilter, error) {
+	if !strings.HasPrefix(strings.TrimSpace(where), "-") {
+		return &model.BeanFilter{Where: &where}, nil
+	}
+	args, err := splitArgs(where)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --where: %w", err)
+	}
+	flags := listCmd.Flags()
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("invalid --where: %w", err)
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("invalid --where: unexpected argument %q (use beans list flags, e.g. \"--status todo\")", flags.Arg(0))
+	}
+	return buildListFilter()
+}
+
+// splitArgs splits a command line into arguments at whitespace, honouring
+// single and double quotes and backslash escapes.
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", s)
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args, nil
+}
+
 // buildUpdateInput constructs the GraphQL input from flags and returns which fields changed.
 func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody string) (model.UpdateBeanInput, []string, error) {
 	var input model.UpdateBeanInput
@@ -202,6 +357,59 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "tags")
 	}
 
+	// Handle assignees
+	if len(updateAssignee) > 0 {
+		assignees, err := resolveAssignees(updateAssignee)
+		if err != nil {
+			return input, nil, err
+		}
+		input.AddAssignees = assignees
+		changes = append(changes, "assignees")
+	}
+	if len(updateRemoveAssignee) > 0 {
+		assignees, err := resolveAssignees(updateRemoveAssignee)
+		if err != nil {
+			return input, nil, err
+		}
+		input.RemoveAssignees = assignees
+		changes = append(changes, "assignees")
+	}
+
+	// Handle planning dates (empty clears)
+	if cmd.Flags().Changed("start") {
+		if updateStart != "" {
+			if _, err := bean.ParseDate(updateStart); err != nil {
+				return input, nil, err
+			}
+		}
+		input.StartAt = &updateStart
+		changes = append(changes, "start")
+	}
+	if cmd.Flags().Changed("due") {
+		if updateDue != "" {
+			if _, err := bean.ParseDate(updateDue); err != nil {
+				return input, nil, err
+			}
+		}
+		input.DueAt = &updateDue
+		changes = append(changes, "due")
+	}
+
+	if cmd.Flags().Changed("estimate") {
+		input.Estimate = &updateEstimate
+		changes = append(changes, "estimate")
+	}
+
+	// Handle custom fields (validated against .beans.yml by the resolver)
+	if len(updateField) > 0 {
+		fields, err := parseFieldFlags(updateField)
+		if err != nil {
+			return input, nil, err
+		}
+		input.Fields = fields
+		changes = append(changes, "fields")
+	}
+
 	// Handle parent relationship
 	if cmd.Flags().Changed("parent") {
 		input.Parent = &updateParent
@@ -232,6 +440,24 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "blocked-by")
 	}
 
+	// Handle typed links
+	if len(updateLink) > 0 {
+		links, err := parseLinkFlags(updateLink)
+		if err != nil {
+			return input, nil, err
+		}
+		input.AddLinks = links
+		changes = append(changes, "links")
+	}
+	if len(updateRemoveLink) > 0 {
+		links, err := parseLinkFlags(updateRemoveLink)
+		if err != nil {
+			return input, nil, err
+		}
+		input.RemoveLinks = links
+		changes = append(changes, "links")
+	}
+
 	return input, changes, nil
 }
 
@@ -239,9 +465,12 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 func hasFieldUpdates(input model.UpdateBeanInput) bool {
 	return input.Status != nil || input.Type != nil || input.Priority != nil ||
 		input.Title != nil || input.Body != nil || input.BodyMod != nil || input.Tags != nil ||
-		input.AddTags != nil || input.RemoveTags != nil ||
+		input.AddTags != nil || input.RemoveTags != nil || input.Fields != nil ||
+		input.Assignees != nil || input.AddAssignees != nil || input.RemoveAssignees != nil ||
+		input.StartAt != nil || input.DueAt != nil || input.Estimate != nil ||
 		input.Parent != nil || input.AddBlocking != nil || input.RemoveBlocking != nil ||
-		input.AddBlockedBy != nil || input.RemoveBlockedBy != nil
+		input.AddBlockedBy != nil || input.RemoveBlockedBy != nil ||
+		input.AddLinks != nil || input.RemoveLinks != nil
 }
 
 // isConflictError returns true if the error is an ETag-related conflict error.
@@ -260,7 +489,8 @@ func mutationError(jsonOutput bool, err error) error {
 }
 
 func RegisterUpdateCmd(root *cobra.Command) {
-	// Build help text with allowed values from hardcoded config
+	// Build help text from the built-in values. Flags are registered before
+	// .beans.yml is loaded, so custom statuses and types can't be listed here.
 	statusNames := make([]string, len(config.DefaultStatuses))
 	for i, s := range config.DefaultStatuses {
 		statusNames[i] = s.Name
@@ -274,8 +504,8 @@ func RegisterUpdateCmd(root *cobra.Command) {
 		priorityNames[i] = p.Name
 	}
 
-	updateCmd.Flags().StringVarP(&updateStatus, "status", "s", "", "New status ("+strings.Join(statusNames, ", ")+")")
-	updateCmd.Flags().StringVarP(&updateType, "type", "t", "", "New type ("+strings.Join(typeNames, ", ")+")")
+	updateCmd.Flags().StringVarP(&updateStatus, "status", "s", "", "New status ("+strings.Join(statusNames, ", ")+", or a custom status from .beans.yml)")
+	updateCmd.Flags().StringVarP(&updateType, "type", "t", "", "New type ("+strings.Join(typeNames, ", ")+", or a custom type from .beans.yml)")
 	updateCmd.Flags().StringVarP(&updatePriority, "priority", "p", "", "New priority ("+strings.Join(priorityNames, ", ")+", or empty to clear)")
 	updateCmd.Flags().StringVar(&updateTitle, "title", "", "New title")
 	updateCmd.Flags().StringVarP(&updateBody, "body", "d", "", "New body (use '-' to read from stdin)")
@@ -289,9 +519,19 @@ func RegisterUpdateCmd(root *cobra.Command) {
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlocking, "remove-blocking", nil, "ID of bean to unblock (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlockedBy, "remove-blocked-by", nil, "ID of blocker bean to remove (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateLink, "link", nil, "Add a typed link as type:id, e.g. duplicates:abc1 (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveLink, "remove-link", nil, "Remove a typed link given as type:id (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateTag, "tag", nil, "Add tag (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveTag, "remove-tag", nil, "Remove tag (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateAssignee, "assignee", nil, "Assign to a user, or 'me' for yourself (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveAssignee, "remove-assignee", nil, "Unassign a user, or 'me' for yourself (can be repeated)")
+	updateCmd.Flags().StringVar(&updateStart, "start", "", "Set start date (YYYY-MM-DD or RFC 3339, empty to clear)")
+	updateCmd.Flags().StringVar(&updateDue, "due", "", "Set due date (YYYY-MM-DD or RFC 3339, empty to clear)")
+	updateCmd.Flags().Float64Var(&updateEstimate, "estimate", 0, "Set estimated effort (0 to clear)")
+	updateCmd.Flags().StringArrayVar(&updateField, "field", nil, "Set a custom field as name=value, or name= to remove it (can be repeated)")
 	updateCmd.Flags().StringVar(&updateIfMatch, "if-match", "", "Only update if etag matches (optimistic locking)")
+	updateCmd.Flags().StringVar(&updateWhere, "where", "", "Update every bean matching a query expression or beans list flags instead of one ID (e.g. \"status = todo\" or \"--status todo\")")
+	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "With --where, show the changes without writing them")
 	updateCmd.MarkFlagsMutuallyExclusive("parent", "remove-parent")
 	updateCmd.Flags().BoolVar(&updateJSON, "json", false, "Output as JSON")
 	// body and body-file are mutually exclusive with body modifications
@@ -1,4 +1,122 @@
 package commands
 
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/spf13/cobra"
+)
+
 // Tests for parseLink and isKnownLinkType have been moved to content_test.go
 // since those functions now live in content.go
+
+func TestUpdateStatusRespectsTransitions(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+
+	oldCfg := cfg
+	cfg = testCore.Config()
+	defer func() { cfg = oldCfg }()
+
+	cfg.Transitions = map[string][]string{"todo": {"in-progress"}}
+	createQueryTestBean(t, testCore, "flow-1", "Workflow Bean", "todo")
+
+	oldStatus := updateStatus
+	defer func() { updateStatus = oldStatus }()
+
+	update := func(status string) error {
+		cmd := &cobra.Command{}
+		cmd.Flags().StringVar(&updateStatus, "status", "", "")
+		if err := cmd.Flags().Set("status", status); err != nil {
+			t.Fatalf("Set(status) error = %v", err)
+		}
+		input, _, err := buildUpdateInput(cmd, nil, "")
+		if err != nil {
+			return err
+		}
+		resolver := &beangraph.CoreResolver{Core: testCore}
+		_, err = resolver.UpdateBean(context.Background(), "flow-1", input)
+		return err
+	}
+
+	err := update("completed")
+	if err == nil {
+		t.Fatal("update to completed: error = nil, want transition error")
+	}
+	if !strings.Contains(err.Error(), `status transition from "todo" to "completed" is not allowed (allowed: in-progress)`) {
+		t.Errorf("error = %q, want transition error listing allowed statuses", err)
+	}
+	if b, _ := testCore.Get("flow-1"); b.Status != "todo" {
+		t.Errorf("status = %q, want unchanged %q", b.Status, "todo")
+	}
+
+	if err := update("in-progress"); err != nil {
+		t.Errorf("update to in-progress: error = %v, want nil", err)
+	}
+}
+
+func TestParseFieldFlags(t *testing.T) {
+	fields, err := parseFieldFlags([]string{"points=3", "note=a=b", "owner="})
+	if err != nil {
+		t.Fatalf("parseFieldFlags() error = %v", err)
+	}
+	want := [][2]string{{"points", "3"}, {"note", "a=b"}, {"owner", ""}}
+	if len(fields) != len(want) {
+		t.Fatalf("parseFieldFlags() returned %d fields, want %d", len(fields), len(want))
+	}
+	for i, w := range want {
+		if fields[i].Name != w[0] || fields[i].Value != w[1] {
+			t.Errorf("field %d = %s=%s, want %s=%s", i, fields[i].Name, fields[i].Value, w[0], w[1])
+		}
+	}
+
+	for _, bad := range []string{"points", "=3"} {
+		if _, err := parseFieldFlags([]string{bad}); err == nil {
+			t.Errorf("parseFieldFlags(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	args, err := splitArgs(`--status todo  --field "team=web core" --search 'a "b"' --tag a\ b`)
+	if err != nil {
+		t.Fatalf("splitArgs() error = %v", err)
+	}
+	want := []string{"--status", "todo", "--field", "team=web core", "--search", `a "b"`, "--tag", "a b"}
+	if strings.Join(args, "|") != strings.Join(want, "|") {
+		t.Errorf("splitArgs() = %q, want %q", args, want)
+	}
+	if _, err := splitArgs(`--search "open`); err == nil {
+		t.Error("splitArgs() with an unterminated quote: error = nil, want error")
+	}
+}
+
+func TestParseWhereFilter(t *testing.T) {
+	if listCmd.Flags().Lookup("status") == nil {
+		RegisterListCmd(&cobra.Command{})
+	}
+	defer func() { listStatus, listType, listTag, listNoPriority = nil, nil, nil, nil }()
+
+	filter, err := parseWhereFilter("--status todo -t bug --tag auth --no-priority low")
+	if err != nil {
+		t.Fatalf("parseWhereFilter() error = %v", err)
+	}
+	if strings.Join(filter.Status, ",") != "todo" || strings.Join(filter.Type, ",") != "bug" ||
+		strings.Join(filter.Tags, ",") != "auth" || strings.Join(filter.ExcludePriority, ",") != "low" {
+		t.Errorf("parseWhereFilter() = %+v", filter)
+	}
+
+	if _, err := parseWhereFilter("--status todo extra"); err == nil || !strings.Contains(err.Error(), "unexpected argument") {
+		t.Errorf("parseWhereFilter(--status todo extra) error = %v, want unexpected argument", err)
+	}
+
+	filter, err = parseWhereFilter("status = todo AND tags = auth")
+	if err != nil {
+		t.Fatalf("parseWhereFilter(expression) error = %v", err)
+	}
+	if filter.Where == nil || *filter.Where != "status = todo AND tags = auth" {
+		t.Errorf("parseWhereFilter(expression) = %+v, want Where set", filter)
+	}
+}
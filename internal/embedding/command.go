@@ -0,0 +1,58 @@
+package embedding
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Command embeds texts by running a shell command, such as a script that
+// runs a local ONNX model. The command reads the texts as a JSON array of
+// strings on stdin and writes a JSON array of vectors (arrays of numbers),
+// one per text, to stdout.
+type Command struct {
+	// Command is the shell command to run.
+	Command string
+	// Dir is the working directory to run it in (empty for the current one).
+	Dir string
+}
+
+// Embed implements Embedder.
+func (c Command) Embed(texts []string) ([][]float64, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	input, err := json.Marshal(texts)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("sh", "-c", c.Command)
+	cmd.Dir = c.Dir
+	cmd.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("embedding command failed: %w: %s", err, msg)
+		}
+		return nil, fmt.Errorf("embedding command failed: %w", err)
+	}
+
+	var vectors [][]float64
+	if err := json.Unmarshal(stdout.Bytes(), &vectors); err != nil {
+		return nil, fmt.Errorf("embedding command returned invalid output: %w", err)
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("embedding command returned %d vectors for %d texts", len(vectors), len(texts))
+	}
+	for _, v := range vectors[1:] {
+		if len(v) != len(vectors[0]) {
+			return nil, fmt.Errorf("embedding command returned vectors of different lengths")
+		}
+	}
+	return vectors, nil
+}
@@ -0,0 +1,131 @@
+// Package embedding turns bean texts into vectors for similarity search, so
+// that related beans can be found even when they share few words. The
+// default LSA embedder works offline on the texts themselves; other backends,
+// such as a local model, can be plugged in through the Embedder interface.
+package embedding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Embedder turns texts into vectors whose cosine similarity reflects how
+// related the texts are.
+type Embedder interface {
+	// Embed returns one vector per text, all of the same length. The whole
+	// corpus is embedded in one call, since some embedders (like LSA) derive
+	// their vocabulary and weights from it.
+	Embed(texts []string) ([][]float64, error)
+}
+
+// Document is a text to embed, identified by ID.
+type Document struct {
+	ID   string
+	Text string
+}
+
+// Match is a document similar to another one, with the cosine similarity of
+// their vectors (1 is identical).
+type Match struct {
+	ID    string
+	Score float64
+}
+
+// Index holds the vectors of a set of documents for similarity lookups.
+type Index struct {
+	ids         []string
+	vectors     [][]float64
+	positions   map[string]int
+	fingerprint string
+}
+
+// Build embeds docs and returns an index of their vectors.
+func Build(e Embedder, docs []Document) (*Index, error) {
+	texts := make([]string, len(docs))
+	for i, d := range docs {
+		texts[i] = d.Text
+	}
+	vectors, err := e.Embed(texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(docs) {
+		return nil, fmt.Errorf("embedder returned %d vectors for %d texts", len(vectors), len(docs))
+	}
+
+	idx := &Index{
+		ids:         make([]string, len(docs)),
+		vectors:     vectors,
+		positions:   make(map[string]int, len(docs)),
+		fingerprint: Fingerprint(docs),
+	}
+	for i, d := range docs {
+		idx.ids[i] = d.ID
+		idx.positions[d.ID] = i
+	}
+	return idx, nil
+}
+
+// Fingerprint returns a hash of docs' IDs and texts. An index whose
+// fingerprint differs from that of the current documents is out of date.
+func Fingerprint(docs []Document) string {
+	h := fnv.New64a()
+	for _, d := range docs {
+		h.Write([]byte(d.ID))
+		h.Write([]byte{0})
+		h.Write([]byte(d.Text))
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// Fingerprint returns the fingerprint of the documents the index was built from.
+func (idx *Index) Fingerprint() string {
+	return idx.fingerprint
+}
+
+// Similar returns up to limit documents most similar to the one with the
+// given ID, best first. Only documents with a score above minScore are
+// returned. Returns nil if the ID is not in the index.
+func (idx *Index) Similar(id string, limit int, minScore float64) []Match {
+	pos, ok := idx.positions[id]
+	if !ok {
+		return nil
+	}
+
+	var matches []Match
+	for i, v := range idx.vectors {
+		if i == pos {
+			continue
+		}
+		if score := Cosine(idx.vectors[pos], v); score > minScore {
+			matches = append(matches, Match{ID: idx.ids[i], Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool { return matches[a].Score > matches[b].Score })
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Cosine returns the cosine similarity of two vectors, or 0 if either is
+// zero or their lengths differ.
+func Cosine(a, b []float64) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(na*nb)
+}
@@ -0,0 +1,129 @@
+package embedding
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// corpus has two texts about the same problem that share no words ("Login
+// broken" and "Auth failure"), and others that tie login and auth together.
+var corpus = []Document{
+	{ID: "dup1", Text: "Login broken"},
+	{ID: "dup2", Text: "Auth failure"},
+	{ID: "rel1", Text: "Login page shows auth error, session auth token invalid"},
+	{ID: "rel2", Text: "Auth service rejects login with valid password"},
+	{ID: "rel3", Text: "Broken auth redirect after login failure"},
+	{ID: "db1", Text: "Database migration fails on startup"},
+	{ID: "db2", Text: "Slow database queries on the report page"},
+	{ID: "db3", Text: "Database index missing for report queries"},
+	{ID: "ui1", Text: "Dark mode theme colors"},
+	{ID: "ui2", Text: "Theme switcher ignores dark mode setting"},
+}
+
+func TestLSA_RelatesTextsWithoutCommonWords(t *testing.T) {
+	idx, err := Build(LSA{}, corpus)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	matches := idx.Similar("dup1", 3, 0)
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	if !strings.Contains(strings.Join(ids, ","), "dup2") {
+		t.Errorf("Similar(dup1) = %v, want dup2 among the top 3", matches)
+	}
+	for _, m := range matches {
+		if strings.HasPrefix(m.ID, "db") || strings.HasPrefix(m.ID, "ui") {
+			t.Errorf("Similar(dup1) includes unrelated %s: %v", m.ID, matches)
+		}
+	}
+
+	if m := idx.Similar("db2", 1, 0); len(m) != 1 || !strings.HasPrefix(m[0].ID, "db") {
+		t.Errorf("Similar(db2) = %v, want another database bean", m)
+	}
+	if m := idx.Similar("missing", 3, 0); m != nil {
+		t.Errorf("Similar(missing) = %v, want nil", m)
+	}
+}
+
+func TestLSA_Deterministic(t *testing.T) {
+	a, _ := LSA{}.Embed([]string{"login broken", "auth failure", "login auth", "broken failure"})
+	b, _ := LSA{}.Embed([]string{"login broken", "auth failure", "login auth", "broken failure"})
+	for i := range a {
+		for j := range a[i] {
+			if a[i][j] != b[i][j] {
+				t.Fatalf("Embed() is not deterministic: %v vs %v", a, b)
+			}
+		}
+	}
+}
+
+func TestLSA_EdgeCases(t *testing.T) {
+	for _, texts := range [][]string{nil, {"only one"}, {"", ""}, {"unique words", "nothing shared"}} {
+		vectors, err := LSA{}.Embed(texts)
+		if err != nil {
+			t.Fatalf("Embed(%q) error = %v", texts, err)
+		}
+		if len(vectors) != len(texts) {
+			t.Errorf("Embed(%q) returned %d vectors", texts, len(vectors))
+		}
+	}
+}
+
+func TestSimilar_LimitAndMinScore(t *testing.T) {
+	idx, err := Build(LSA{}, corpus)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if m := idx.Similar("rel1", 2, 0); len(m) != 2 || m[0].Score < m[1].Score {
+		t.Errorf("Similar(rel1, 2) = %v, want 2 matches best first", m)
+	}
+	for _, m := range idx.Similar("rel1", 0, 0.5) {
+		if m.Score <= 0.5 {
+			t.Errorf("Similar(minScore 0.5) returned %v", m)
+		}
+	}
+}
+
+func TestCommand(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "embed.sh")
+	// Embeds every text as [1, 0], except texts containing "other" as [0, 1]
+	content := `#!/bin/sh
+sed -e 's/"[^"]*other[^"]*"/[0,1]/g' -e 's/"[^"]*"/[1,0]/g'
+`
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	idx, err := Build(Command{Command: "./embed.sh", Dir: dir}, []Document{
+		{ID: "a", Text: "first"}, {ID: "b", Text: "second"}, {ID: "c", Text: "the other"},
+	})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if m := idx.Similar("a", 5, 0); len(m) != 1 || m[0].ID != "b" || m[0].Score != 1 {
+		t.Errorf("Similar(a) = %v, want [b 1]", m)
+	}
+
+	if _, err := (Command{Command: "echo nope >&2; exit 3"}).Embed([]string{"x"}); err == nil || !strings.Contains(err.Error(), "nope") {
+		t.Errorf("failing command: error = %v, want its stderr", err)
+	}
+	if _, err := (Command{Command: "echo '[[1]]'"}).Embed([]string{"x", "y"}); err == nil {
+		t.Error("command returning too few vectors: error = nil")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a := Fingerprint([]Document{{ID: "a", Text: "x"}})
+	if a != Fingerprint([]Document{{ID: "a", Text: "x"}}) {
+		t.Error("Fingerprint() differs for equal documents")
+	}
+	if a == Fingerprint([]Document{{ID: "a", Text: "y"}}) {
+		t.Error("Fingerprint() is equal for different texts")
+	}
+}
@@ -0,0 +1,221 @@
+package embedding
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// DefaultDimensions is the default number of LSA dimensions.
+const DefaultDimensions = 100
+
+// lsaIterations is the number of subspace iterations used to approximate the
+// top singular vectors.
+const lsaIterations = 4
+
+// LSA embeds texts with latent semantic analysis: TF-IDF weighted term
+// vectors projected onto the corpus's top singular vectors. Texts that use
+// terms which tend to occur together end up close, even without words in
+// common. It needs no model or network access.
+type LSA struct {
+	// Dimensions is the maximum number of dimensions; 0 uses
+	// DefaultDimensions. Smaller corpora use fewer: the square root of the
+	// number of texts, so that only their main topics are kept.
+	Dimensions int
+}
+
+// sparseRow is a text's term weights, by term index.
+type sparseRow struct {
+	terms   []int
+	weights []float64
+}
+
+// Embed implements Embedder.
+func (l LSA) Embed(texts []string) ([][]float64, error) {
+	rows, numTerms := tfidf(texts)
+
+	k := l.Dimensions
+	if k <= 0 {
+		k = DefaultDimensions
+	}
+	k = min(k, max(2, int(math.Sqrt(float64(len(texts))))), numTerms)
+	if k == 0 {
+		return make([][]float64, len(texts)), nil
+	}
+
+	// Approximate the top k right singular vectors of the text-term matrix
+	// X by subspace iteration: Q = orth(Xᵀ X Q), starting from a fixed random
+	// basis so that results are reproducible.
+	rng := rand.New(rand.NewSource(1))
+	q := make([][]float64, k)
+	for j := range q {
+		q[j] = make([]float64, numTerms)
+		for t := range q[j] {
+			q[j][t] = rng.NormFloat64()
+		}
+	}
+	orthonormalize(q)
+	for range lsaIterations {
+		projected := project(rows, termMajor(q))
+		next := make([]float64, numTerms*k)
+		for i, row := range rows {
+			for n, t := range row.terms {
+				w, acc := row.weights[n], next[t*k:(t+1)*k]
+				for j, x := range projected[i] {
+					acc[j] += w * x
+				}
+			}
+		}
+		for j := range q {
+			for t := range q[j] {
+				q[j][t] = next[t*k+j]
+			}
+		}
+		orthonormalize(q)
+	}
+
+	return project(rows, termMajor(q)), nil
+}
+
+// termMajor flattens the basis q so that each term's coordinates are
+// contiguous, which keeps projecting sparse rows cache-friendly.
+func termMajor(q [][]float64) [][]float64 {
+	flat := make([]float64, len(q)*len(q[0]))
+	basis := make([][]float64, len(q[0]))
+	for t := range basis {
+		basis[t] = flat[t*len(q) : (t+1)*len(q)]
+		for j := range q {
+			basis[t][j] = q[j][t]
+		}
+	}
+	return basis
+}
+
+// project returns each row's coordinates in a basis given by term.
+func project(rows []sparseRow, basis [][]float64) [][]float64 {
+	vectors := make([][]float64, len(rows))
+	for i, row := range rows {
+		vectors[i] = make([]float64, len(basis[0]))
+		for n, t := range row.terms {
+			w := row.weights[n]
+			for j, x := range basis[t] {
+				vectors[i][j] += w * x
+			}
+		}
+	}
+	return vectors
+}
+
+// orthonormalize makes the vectors of q orthonormal with modified
+// Gram-Schmidt. Vectors that are (nearly) dependent on earlier ones are
+// zeroed.
+func orthonormalize(q [][]float64) {
+	for j := range q {
+		for p := range j {
+			var dot float64
+			for t := range q[j] {
+				dot += q[j][t] * q[p][t]
+			}
+			for t := range q[j] {
+				q[j][t] -= dot * q[p][t]
+			}
+		}
+		var norm float64
+		for _, x := range q[j] {
+			norm += x * x
+		}
+		norm = math.Sqrt(norm)
+		if norm < 1e-10 {
+			clear(q[j])
+			continue
+		}
+		for t := range q[j] {
+			q[j][t] /= norm
+		}
+	}
+}
+
+// tfidf returns the unit-length TF-IDF rows of texts and the number of
+// terms. Terms that occur in only one text are left out: they can't make
+// two texts similar.
+func tfidf(texts []string) ([]sparseRow, int) {
+	counts := make([]map[string]int, len(texts))
+	df := make(map[string]int)
+	for i, text := range texts {
+		counts[i] = make(map[string]int)
+		for _, term := range tokenize(text) {
+			if counts[i][term] == 0 {
+				df[term]++
+			}
+			counts[i][term]++
+		}
+	}
+
+	// Number the terms in sorted order, so that results don't depend on map
+	// iteration order
+	var terms []string
+	for term, n := range df {
+		if n > 1 {
+			terms = append(terms, term)
+		}
+	}
+	slices.Sort(terms)
+	vocab := make(map[string]int, len(terms))
+	for t, term := range terms {
+		vocab[term] = t
+	}
+
+	rows := make([]sparseRow, len(texts))
+	for i := range texts {
+		for term := range counts[i] {
+			if t, ok := vocab[term]; ok {
+				rows[i].terms = append(rows[i].terms, t)
+			}
+		}
+		slices.Sort(rows[i].terms)
+
+		var norm float64
+		for _, t := range rows[i].terms {
+			term := terms[t]
+			w := (1 + math.Log(float64(counts[i][term]))) * math.Log(1+float64(len(texts))/float64(df[term]))
+			rows[i].weights = append(rows[i].weights, w)
+			norm += w * w
+		}
+		norm = math.Sqrt(norm)
+		for n := range rows[i].weights {
+			rows[i].weights[n] /= norm
+		}
+	}
+	return rows, len(terms)
+}
+
+// stopwords are common English words that say nothing about a bean's topic.
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"but": true, "by": true, "can": true, "do": true, "does": true, "for": true, "from": true,
+	"has": true, "have": true, "if": true, "in": true, "into": true, "is": true, "it": true,
+	"its": true, "not": true, "of": true, "on": true, "or": true, "should": true, "so": true,
+	"that": true, "the": true, "then": true, "there": true, "this": true, "to": true,
+	"was": true, "we": true, "when": true, "which": true, "will": true, "with": true,
+}
+
+// tokenize splits text into lowercase terms of letters and digits, without
+// stopwords, single characters and plural s.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := words[:0]
+	for _, w := range words {
+		if len(w) < 2 || stopwords[w] {
+			continue
+		}
+		if len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") {
+			w = w[:len(w)-1]
+		}
+		terms = append(terms, w)
+	}
+	return terms
+}
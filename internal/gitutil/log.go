@@ -0,0 +1,102 @@
+package gitutil
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Revision is a commit that touched a file, as reported by git log --follow.
+type Revision struct {
+	Hash    string
+	Author  string
+	Email   string
+	Time    time.Time
+	Subject string
+	// Path is the repo-relative path of the file in this commit, which
+	// differs from the current path if the file was renamed or moved later.
+	Path string
+	// Deleted is true if this commit removed the file.
+	Deleted bool
+}
+
+// FileLog returns the commits that touched the file at path, following
+// renames, newest first. Returns an empty slice if the file was never committed.
+func FileLog(dir, path string) ([]Revision, error) {
+	cmd := exec.Command("git", "-C", dir, "log", "--follow", "--name-status",
+		"--format=%x1e%H%x1f%an%x1f%ae%x1f%aI%x1f%s", "--", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log: %w", commandError(err))
+	}
+	return parseFileLog(string(out))
+}
+
+// parseFileLog parses the output of FileLog's git log invocation. Each record
+// starts with a 0x1e byte, followed by 0x1f-separated header fields and the
+// --name-status lines for the file.
+func parseFileLog(output string) ([]Revision, error) {
+	var revisions []Revision
+	for _, record := range strings.Split(output, "\x1e") {
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		header, rest, _ := strings.Cut(record, "\n")
+		fields := strings.Split(header, "\x1f")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected git log header: %q", header)
+		}
+		t, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("parsing commit time: %w", err)
+		}
+		rev := Revision{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Time:    t,
+			Subject: fields[4],
+		}
+
+		// Name-status lines: "M\tpath", "D\tpath" or "R100\told\tnew"
+		for _, line := range strings.Split(rest, "\n") {
+			parts := strings.Split(line, "\t")
+			if len(parts) < 2 {
+				continue
+			}
+			rev.Path = parts[len(parts)-1]
+			rev.Deleted = parts[0] == "D"
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+// ShowFile returns the content of the repo-relative path at the given commit.
+func ShowFile(dir, rev, path string) ([]byte, error) {
+	cmd := exec.Command("git", "-C", dir, "show", rev+":"+path)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git show %s:%s: %w", rev, path, commandError(err))
+	}
+	return out, nil
+}
+
+// RepoPrefix returns dir's path relative to the root of its repository, with
+// a trailing slash (empty at the root).
+func RepoPrefix(dir string) (string, error) {
+	prefix, err := gitRevParse(dir, "--show-prefix")
+	if err != nil {
+		return "", fmt.Errorf("not a git repository: %s", dir)
+	}
+	return prefix, nil
+}
+
+// commandError adds git's stderr output to an exec error when available.
+func commandError(err error) error {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%s", strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
@@ -287,6 +287,16 @@ func HasUnpushedCommits(dir string) bool {
 	return count > 0
 }
 
+// UserEmail returns git's configured user.email for the repository at dir,
+// or an empty string if it is not set.
+func UserEmail(dir string) string {
+	out, err := exec.Command("git", "-C", dir, "config", "user.email").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
 // HasChanges returns true if there are any uncommitted changes or untracked files.
 func HasChanges(dir string) bool {
 	changes, err := FileChanges(dir)
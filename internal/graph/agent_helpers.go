@@ -170,7 +170,7 @@ type actionContext struct {
 	MainRepoHasChanges bool   // main repo has uncommitted changes
 	MainRepoPath       string // absolute path to the main repo working directory
 	PullRequest        *forge.PullRequest
-	ForgeCLI           string // "gh", "glab", or "" if no forge detected
+	ForgeCLI           string // "gh", "glab", "tea", or "" if no forge detected
 	ForgeLoading       bool   // true when forge is detected but PR state hasn't been fetched yet
 	IntegrateMode      string // "local" or "pr" — controls which integration buttons are visible
 }
@@ -404,6 +404,14 @@ func commitPrompt(_ actionContext) string {
 	return "Create a commit. Examine the current git status and diff, then commit with an appropriate message. If there are non-bean changes, make sure there is an associated bean that is up to date. If the only changes are bean files, describe the bean updates in the commit message."
 }
 
+// canMergeDirectly reports whether the PR action would only merge the PR:
+// it is open and mergeable, and there is nothing left to push.
+func canMergeDirectly(ctx actionContext) bool {
+	pr := ctx.PullRequest
+	return ctx.ForgeCLI != "" && pr != nil && pr.State == "open" && pr.CanMerge() &&
+		!ctx.HasChanges && !ctx.HasUnpushedCommits
+}
+
 // findAgentAction looks up an action by ID, returning nil if not found.
 func findAgentAction(id string) *agentActionDef {
 	for i := range agentActions {
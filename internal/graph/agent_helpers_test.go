@@ -87,3 +87,35 @@ func TestPrPrompt_UsesForgeCLI(t *testing.T) {
 		}
 	}
 }
+
+func TestCanMergeDirectly(t *testing.T) {
+	mergeable := func() *forge.PullRequest {
+		return &forge.PullRequest{Number: 1, State: "open", Checks: forge.CheckStatusPass, Mergeable: true}
+	}
+	draft := mergeable()
+	draft.IsDraft = true
+	failing := mergeable()
+	failing.Checks = forge.CheckStatusFail
+	merged := mergeable()
+	merged.State = "merged"
+
+	tests := []struct {
+		name string
+		ctx  actionContext
+		want bool
+	}{
+		{"mergeable", actionContext{ForgeCLI: "gh", PullRequest: mergeable()}, true},
+		{"no forge", actionContext{PullRequest: mergeable()}, false},
+		{"no PR", actionContext{ForgeCLI: "gh"}, false},
+		{"local changes", actionContext{ForgeCLI: "gh", PullRequest: mergeable(), HasChanges: true}, false},
+		{"unpushed commits", actionContext{ForgeCLI: "gh", PullRequest: mergeable(), HasUnpushedCommits: true}, false},
+		{"draft", actionContext{ForgeCLI: "gh", PullRequest: draft}, false},
+		{"checks failing", actionContext{ForgeCLI: "gh", PullRequest: failing}, false},
+		{"already merged", actionContext{ForgeCLI: "gh", PullRequest: merged}, false},
+	}
+	for _, tc := range tests {
+		if got := canMergeDirectly(tc.ctx); got != tc.want {
+			t.Errorf("%s: canMergeDirectly() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hmans/beans/internal/agent"
 	"github.com/hmans/beans/internal/gitutil"
@@ -124,3 +125,49 @@ func forgePRToModel(pr *forge.PullRequest) *model.PullRequest {
 		Mergeable:      pr.Mergeable,
 	}
 }
+
+// workspacePR returns the branch of a workspace (a worktree, or the project
+// root for the central session) and the open pull/merge request for it.
+func (r *Resolver) workspacePR(ctx context.Context, workspaceID string) (string, *forge.PullRequest, error) {
+	if r.Forge == nil {
+		return "", nil, fmt.Errorf("no git forge detected for this project")
+	}
+	dir := r.ProjectRoot
+	if workspaceID != CentralSessionID {
+		var err error
+		if dir, err = r.findWorktreePath(workspaceID); err != nil {
+			return "", nil, err
+		}
+	}
+	branch, ok := gitutil.CurrentBranch(dir)
+	if !ok {
+		return "", nil, fmt.Errorf("cannot determine the branch of workspace %s", workspaceID)
+	}
+	pr, err := r.Forge.FindPR(ctx, r.ProjectRoot, branch)
+	if err != nil {
+		return "", nil, err
+	}
+	if pr == nil || pr.State != "open" {
+		return "", nil, fmt.Errorf("no open pull request for branch %s", branch)
+	}
+	return branch, pr, nil
+}
+
+// pullRequestAction runs an action on the open pull/merge request of a
+// workspace's branch and returns the pull request as the forge reports it
+// afterwards (nil if it no longer finds it).
+func (r *Resolver) pullRequestAction(ctx context.Context, workspaceID string, action func(pr *forge.PullRequest) error) (*model.PullRequest, error) {
+	branch, pr, err := r.workspacePR(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := action(pr); err != nil {
+		return nil, err
+	}
+	// FindPRs also returns merged PRs, unlike FindPR
+	prs, err := r.Forge.FindPRs(ctx, r.ProjectRoot, []string{branch})
+	if err != nil || prs[branch] == nil {
+		return nil, nil
+	}
+	return forgePRToModel(prs[branch]), nil
+}
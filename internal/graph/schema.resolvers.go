@@ -23,8 +23,14 @@ import (
 	"github.com/hmans/beans/pkg/beancore"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/pkg/forge"
 )
 
+// IsOverdue is the resolver for the isOverdue field.
+func (r *beanResolver) IsOverdue(ctx context.Context, obj *bean.Bean) (bool, error) {
+	return r.CoreResolver.BeanIsOverdue(ctx, obj)
+}
+
 // IsDirty is the resolver for the isDirty field.
 func (r *beanResolver) IsDirty(ctx context.Context, obj *bean.Bean) (bool, error) {
 	return r.CoreResolver.BeanIsDirty(ctx, obj)
@@ -35,6 +41,11 @@ func (r *beanResolver) WorktreeID(ctx context.Context, obj *bean.Bean) (*string,
 	return r.CoreResolver.BeanWorktreeID(ctx, obj)
 }
 
+// Fields is the resolver for the fields field.
+func (r *beanResolver) Fields(ctx context.Context, obj *bean.Bean) (map[string]any, error) {
+	return r.CoreResolver.BeanFields(ctx, obj)
+}
+
 // ParentID is the resolver for the parentId field.
 func (r *beanResolver) ParentID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	return r.CoreResolver.BeanParentID(ctx, obj)
@@ -50,6 +61,11 @@ func (r *beanResolver) BlockedByIds(ctx context.Context, obj *bean.Bean) ([]stri
 	return r.CoreResolver.BeanBlockedByIds(ctx, obj)
 }
 
+// Links is the resolver for the links field.
+func (r *beanResolver) Links(ctx context.Context, obj *bean.Bean) ([]*model.BeanLink, error) {
+	return r.CoreResolver.BeanLinks(ctx, obj)
+}
+
 // BlockedBy is the resolver for the blockedBy field.
 func (r *beanResolver) BlockedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
 	return r.CoreResolver.BeanBlockedBy(ctx, obj, filter)
@@ -70,6 +86,56 @@ func (r *beanResolver) Children(ctx context.Context, obj *bean.Bean, filter *mod
 	return r.CoreResolver.BeanChildren(ctx, obj, filter)
 }
 
+// RelatesTo is the resolver for the relatesTo field.
+func (r *beanResolver) RelatesTo(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanRelatesTo(ctx, obj, filter)
+}
+
+// Duplicates is the resolver for the duplicates field.
+func (r *beanResolver) Duplicates(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanDuplicates(ctx, obj, filter)
+}
+
+// DuplicatedBy is the resolver for the duplicatedBy field.
+func (r *beanResolver) DuplicatedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanDuplicatedBy(ctx, obj, filter)
+}
+
+// CausedBy is the resolver for the causedBy field.
+func (r *beanResolver) CausedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanCausedBy(ctx, obj, filter)
+}
+
+// Causes is the resolver for the causes field.
+func (r *beanResolver) Causes(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanCauses(ctx, obj, filter)
+}
+
+// Linked is the resolver for the linked field.
+func (r *beanResolver) Linked(ctx context.Context, obj *bean.Bean, typeArg string, inverse *bool, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.CoreResolver.BeanLinked(ctx, obj, typeArg, inverse, filter)
+}
+
+// TotalEstimate is the resolver for the totalEstimate field.
+func (r *beanResolver) TotalEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.CoreResolver.BeanTotalEstimate(ctx, obj)
+}
+
+// CompletedEstimate is the resolver for the completedEstimate field.
+func (r *beanResolver) CompletedEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	return r.CoreResolver.BeanCompletedEstimate(ctx, obj)
+}
+
+// History is the resolver for the history field.
+func (r *beanResolver) History(ctx context.Context, obj *bean.Bean) ([]*model.BeanHistoryEntry, error) {
+	return r.CoreResolver.BeanHistory(ctx, obj)
+}
+
+// Similar is the resolver for the similar field.
+func (r *beanResolver) Similar(ctx context.Context, obj *bean.Bean, limit *int) ([]*model.SimilarBean, error) {
+	return r.CoreResolver.BeanSimilar(ctx, obj, limit)
+}
+
 // ImplicitStatus is the resolver for the implicitStatus field.
 func (r *beanResolver) ImplicitStatus(ctx context.Context, obj *bean.Bean) (*string, error) {
 	return r.CoreResolver.BeanImplicitStatus(ctx, obj)
@@ -90,11 +156,26 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 	return r.CoreResolver.UpdateBean(ctx, id, input)
 }
 
+// UpdateBeans is the resolver for the updateBeans field.
+func (r *mutationResolver) UpdateBeans(ctx context.Context, filter model.BeanFilter, input model.UpdateBeanInput, dryRun *bool) ([]*bean.Bean, error) {
+	return r.CoreResolver.UpdateBeans(ctx, filter, input, dryRun)
+}
+
 // DeleteBean is the resolver for the deleteBean field.
 func (r *mutationResolver) DeleteBean(ctx context.Context, id string) (bool, error) {
 	return r.CoreResolver.DeleteBean(ctx, id)
 }
 
+// MergeBean is the resolver for the mergeBean field.
+func (r *mutationResolver) MergeBean(ctx context.Context, id string, into string) (*model.MergeBeanResult, error) {
+	return r.CoreResolver.MergeBean(ctx, id, into)
+}
+
+// Batch is the resolver for the batch field.
+func (r *mutationResolver) Batch(ctx context.Context, operations []*model.BatchOperation) ([]*bean.Bean, error) {
+	return r.CoreResolver.Batch(ctx, operations)
+}
+
 // SetParent is the resolver for the setParent field.
 func (r *mutationResolver) SetParent(ctx context.Context, id string, parentID *string, ifMatch *string) (*bean.Bean, error) {
 	return r.CoreResolver.SetParent(ctx, id, parentID, ifMatch)
@@ -120,6 +201,21 @@ func (r *mutationResolver) RemoveBlockedBy(ctx context.Context, id string, targe
 	return r.CoreResolver.RemoveBlockedBy(ctx, id, targetID, ifMatch)
 }
 
+// AddLink is the resolver for the addLink field.
+func (r *mutationResolver) AddLink(ctx context.Context, id string, typeArg string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	return r.CoreResolver.AddLink(ctx, id, typeArg, targetID, ifMatch)
+}
+
+// RemoveLink is the resolver for the removeLink field.
+func (r *mutationResolver) RemoveLink(ctx context.Context, id string, typeArg string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	return r.CoreResolver.RemoveLink(ctx, id, typeArg, targetID, ifMatch)
+}
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, id string, text string, author *string, ifMatch *string) (*bean.Bean, error) {
+	return r.CoreResolver.AddComment(ctx, id, text, author, ifMatch)
+}
+
 // WriteTerminalInput is the resolver for the writeTerminalInput field.
 // Creates the session on demand if it doesn't exist yet.
 func (r *mutationResolver) WriteTerminalInput(ctx context.Context, sessionID string, data string) (bool, error) {
@@ -436,12 +532,60 @@ func (r *mutationResolver) ExecuteAgentAction(ctx context.Context, beanID string
 		}
 	}
 
+	// Merging is deterministic, so a mergeable PR is merged without asking the agent
+	if action.ID == "create-pr" && canMergeDirectly(actCtx) {
+		if err := r.Forge.MergePR(ctx, r.ProjectRoot, actCtx.PullRequest.Number, forge.MergeMethodDefault); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
 	if err := r.AgentMgr.SendMessage(beanID, workDir, action.PromptFunc(actCtx), nil); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+// MergePullRequest is the resolver for the mergePullRequest field.
+func (r *mutationResolver) MergePullRequest(ctx context.Context, workspaceID string, method *model.MergeMethod) (*model.PullRequest, error) {
+	mergeMethod := forge.MergeMethodDefault
+	if method != nil {
+		mergeMethod = forge.MergeMethod(strings.ToLower(string(*method)))
+	}
+	return r.pullRequestAction(ctx, workspaceID, func(pr *forge.PullRequest) error {
+		return r.Forge.MergePR(ctx, r.ProjectRoot, pr.Number, mergeMethod)
+	})
+}
+
+// ClosePullRequest is the resolver for the closePullRequest field.
+func (r *mutationResolver) ClosePullRequest(ctx context.Context, workspaceID string) (bool, error) {
+	_, pr, err := r.workspacePR(ctx, workspaceID)
+	if err != nil {
+		return false, err
+	}
+	if err := r.Forge.ClosePR(ctx, r.ProjectRoot, pr.Number); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RequestPullRequestReview is the resolver for the requestPullRequestReview field.
+func (r *mutationResolver) RequestPullRequestReview(ctx context.Context, workspaceID string, reviewers []string) (*model.PullRequest, error) {
+	if len(reviewers) == 0 {
+		return nil, fmt.Errorf("at least one reviewer is required")
+	}
+	return r.pullRequestAction(ctx, workspaceID, func(pr *forge.PullRequest) error {
+		return r.Forge.RequestReview(ctx, r.ProjectRoot, pr.Number, reviewers)
+	})
+}
+
+// RerunFailedChecks is the resolver for the rerunFailedChecks field.
+func (r *mutationResolver) RerunFailedChecks(ctx context.Context, workspaceID string) (*model.PullRequest, error) {
+	return r.pullRequestAction(ctx, workspaceID, func(pr *forge.PullRequest) error {
+		return r.Forge.RerunFailedChecks(ctx, r.ProjectRoot, pr.Number)
+	})
+}
+
 // DiscardFileChange is the resolver for the discardFileChange field.
 func (r *mutationResolver) DiscardFileChange(ctx context.Context, filePath string, staged bool, path *string) (bool, error) {
 	dir := r.ProjectRoot
@@ -515,6 +659,16 @@ func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]
 	return r.CoreResolver.Beans(ctx, filter)
 }
 
+// SearchBeans is the resolver for the searchBeans field.
+func (r *queryResolver) SearchBeans(ctx context.Context, query string, limit *int) ([]*model.SearchHit, error) {
+	return r.CoreResolver.SearchBeans(ctx, query, limit)
+}
+
+// Views is the resolver for the views field.
+func (r *queryResolver) Views(ctx context.Context) ([]*config.ViewConfig, error) {
+	return r.CoreResolver.Views(ctx)
+}
+
 // Worktrees is the resolver for the worktrees field.
 func (r *queryResolver) Worktrees(ctx context.Context) ([]*model.Worktree, error) {
 	if r.WorktreeMgr == nil {
@@ -810,6 +964,11 @@ func (r *queryResolver) AgentActions(ctx context.Context, beanID string, skipFor
 	return result, nil
 }
 
+// CurrentUser is the resolver for the currentUser field.
+func (r *queryResolver) CurrentUser(ctx context.Context) (string, error) {
+	return r.CoreResolver.CurrentUser(ctx)
+}
+
 // ProjectName is the resolver for the projectName field.
 func (r *queryResolver) ProjectName(ctx context.Context) (string, error) {
 	return r.CoreResolver.ProjectName(ctx)
@@ -1339,6 +1498,11 @@ func (r *subscriptionResolver) WorkspaceStatuses(ctx context.Context) (<-chan []
 	return out, nil
 }
 
+// Beans is the resolver for the beans field.
+func (r *viewResolver) Beans(ctx context.Context, obj *config.ViewConfig) ([]*bean.Bean, error) {
+	return r.CoreResolver.ViewBeans(ctx, obj)
+}
+
 // Bean returns BeanResolver implementation.
 func (r *Resolver) Bean() BeanResolver { return &beanResolver{r} }
 
@@ -1351,7 +1515,11 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
+// View returns ViewResolver implementation.
+func (r *Resolver) View() ViewResolver { return &viewResolver{r} }
+
 type beanResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type viewResolver struct{ *Resolver }
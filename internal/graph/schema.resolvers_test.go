@@ -7,16 +7,20 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
 	"github.com/hmans/beans/internal/agent"
-	"github.com/hmans/beans/pkg/beangraph"
-	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beangraph"
+	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/pkg/forge"
 )
 
 func setupTestResolver(t *testing.T) (*Resolver, *beancore.Core) {
@@ -845,6 +849,63 @@ func TestMutationCreateBeanWithCustomPrefix(t *testing.T) {
 	})
 }
 
+func TestMutationCreateBeanReportsDuplicates(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	core.Config().Search.DuplicateThreshold = 0.5
+
+	for _, b := range []*bean.Bean{
+		{ID: "dup-1", Title: "Login broken", Status: "completed", Body: "The login form shows an auth error after submitting the password"},
+		{ID: "dup-2", Title: "Database migration", Body: "Add a migration for the orders table and its index"},
+		{ID: "dup-3", Title: "Slow migration", Body: "The orders table migration rebuilds the index too slowly"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	exec := executor.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	run := func(query string) *graphql.Response {
+		t.Helper()
+		ctx := graphql.StartOperationTrace(context.Background())
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: query})
+		if errs != nil {
+			t.Fatalf("CreateOperationContext() errors = %v", errs)
+		}
+		ctx = graphql.WithOperationContext(ctx, opCtx)
+		handler, ctx := exec.DispatchOperation(ctx, opCtx)
+		resp := handler(ctx)
+		if len(resp.Errors) > 0 {
+			t.Fatalf("response errors = %v", resp.Errors)
+		}
+		return resp
+	}
+
+	resp := run(`mutation {
+		a: createBean(input: { title: "Auth failure", body: "Users get an auth error on the login form" }) { id }
+		b: createBean(input: { title: "Keyboard shortcuts", body: "Quit with a shortcut key" }) { id }
+	}`)
+	duplicates, ok := resp.Extensions[beangraph.DuplicatesExtension].(map[string][]beangraph.DuplicateCandidate)
+	if !ok || len(duplicates) != 1 {
+		t.Fatalf("duplicates extension = %v, want one created bean", resp.Extensions)
+	}
+	for id, candidates := range duplicates {
+		created, err := core.Get(id)
+		if err != nil || created.Title != "Auth failure" {
+			t.Errorf("duplicates reported for %s, want the bean titled Auth failure", id)
+		}
+		if len(candidates) != 1 || candidates[0].ID != "dup-1" || candidates[0].Status != "completed" {
+			t.Errorf("candidates = %v, want dup-1", candidates)
+		}
+	}
+
+	// Without a threshold, nothing is checked
+	core.Config().Search.DuplicateThreshold = 0
+	resp = run(`mutation { createBean(input: { title: "Login fails", body: "Auth error on the login form" }) { id } }`)
+	if _, ok := resp.Extensions[beangraph.DuplicatesExtension]; ok {
+		t.Errorf("duplicates extension = %v, want none without a threshold", resp.Extensions)
+	}
+}
+
 func TestMutationUpdateBean(t *testing.T) {
 	resolver, core := setupTestResolver(t)
 	ctx := context.Background()
@@ -3252,3 +3313,1019 @@ func TestListFiles(t *testing.T) {
 	})
 }
 
+func TestUpdateBeanStatusTransitions(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	cfg := core.Config()
+	cfg.CustomStatuses = []config.CustomStatusConfig{{Name: "in-review", Color: "magenta"}}
+	cfg.Transitions = map[string][]string{
+		"todo":        {"in-progress"},
+		"in-progress": {"in-review"},
+	}
+
+	createTestBean(t, core, "flow-1", "Workflow Bean", "todo")
+	mr := resolver.Mutation()
+
+	t.Run("illegal transition is rejected", func(t *testing.T) {
+		status := "completed"
+		_, err := mr.UpdateBean(ctx, "flow-1", model.UpdateBeanInput{Status: &status})
+		if err == nil {
+			t.Fatal("UpdateBean() error = nil, want transition error")
+		}
+		if !strings.Contains(err.Error(), `from "todo" to "completed" is not allowed`) {
+			t.Errorf("error = %q, want transition error", err)
+		}
+		b, _ := core.Get("flow-1")
+		if b.Status != "todo" {
+			t.Errorf("status = %q, want unchanged %q", b.Status, "todo")
+		}
+	})
+
+	t.Run("allowed transitions succeed", func(t *testing.T) {
+		for _, status := range []string{"in-progress", "in-review"} {
+			s := status
+			got, err := mr.UpdateBean(ctx, "flow-1", model.UpdateBeanInput{Status: &s})
+			if err != nil {
+				t.Fatalf("UpdateBean(%s) error = %v", status, err)
+			}
+			if got.Status != status {
+				t.Errorf("status = %q, want %q", got.Status, status)
+			}
+		}
+	})
+
+	t.Run("unrestricted source status allows any transition", func(t *testing.T) {
+		status := "completed"
+		if _, err := mr.UpdateBean(ctx, "flow-1", model.UpdateBeanInput{Status: &status}); err != nil {
+			t.Errorf("UpdateBean() error = %v, want nil", err)
+		}
+	})
+}
+
+func TestCustomFields(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	core.Config().Fields = []config.FieldConfig{
+		{Name: "points", Type: config.FieldTypeNumber},
+		{Name: "severity", Type: config.FieldTypeEnum, Values: []string{"low", "high"}},
+	}
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+
+	t.Run("create with fields", func(t *testing.T) {
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title: "Fielded",
+			Fields: []*model.FieldInput{
+				{Name: "points", Value: "3.0"},
+				{Name: "severity", Value: "high"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		fields, err := resolver.Bean().Fields(ctx, got)
+		if err != nil {
+			t.Fatalf("Fields() error = %v", err)
+		}
+		if fields["points"] != "3" || fields["severity"] != "high" {
+			t.Errorf("Fields() = %v, want points=3 severity=high", fields)
+		}
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		_, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:  "Bad",
+			Fields: []*model.FieldInput{{Name: "severity", Value: "medium"}},
+		})
+		if err == nil || !strings.Contains(err.Error(), "must be low, high") {
+			t.Errorf("CreateBean() error = %v, want enum error", err)
+		}
+		_, err = mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:  "Bad",
+			Fields: []*model.FieldInput{{Name: "color", Value: "red"}},
+		})
+		if err == nil || !strings.Contains(err.Error(), "unknown field: color") {
+			t.Errorf("CreateBean() error = %v, want unknown field error", err)
+		}
+	})
+
+	t.Run("update sets and removes fields", func(t *testing.T) {
+		createTestBean(t, core, "field-1", "Plain", "todo")
+		got, err := mr.UpdateBean(ctx, "field-1", model.UpdateBeanInput{
+			Fields: []*model.FieldInput{{Name: "points", Value: "5"}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.Fields["points"] != "5" {
+			t.Errorf("Fields[points] = %q, want %q", got.Fields["points"], "5")
+		}
+
+		got, err = mr.UpdateBean(ctx, "field-1", model.UpdateBeanInput{
+			Fields: []*model.FieldInput{{Name: "points", Value: ""}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if _, ok := got.Fields["points"]; ok {
+			t.Errorf("Fields = %v, want points removed", got.Fields)
+		}
+	})
+
+	t.Run("filter by fields", func(t *testing.T) {
+		beans, err := qr.Beans(ctx, &model.BeanFilter{
+			Fields: []*model.FieldFilter{{Name: "severity", Values: []string{"high"}}},
+		})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 1 || beans[0].Title != "Fielded" {
+			t.Errorf("Beans(severity=high) = %d beans, want the Fielded bean", len(beans))
+		}
+
+		beans, err = qr.Beans(ctx, &model.BeanFilter{
+			ExcludeFields: []*model.FieldFilter{{Name: "points"}},
+		})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		for _, b := range beans {
+			if _, ok := b.Fields["points"]; ok {
+				t.Errorf("bean %s has points but should be excluded", b.ID)
+			}
+		}
+		if len(beans) != 1 {
+			t.Errorf("Beans(no points) = %d beans, want 1", len(beans))
+		}
+	})
+}
+
+func TestAssignees(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+
+	t.Setenv("BEANS_USER", "alice@example.com")
+
+	t.Run("current user", func(t *testing.T) {
+		user, err := qr.CurrentUser(ctx)
+		if err != nil {
+			t.Fatalf("CurrentUser() error = %v", err)
+		}
+		if user != "alice@example.com" {
+			t.Errorf("CurrentUser() = %q, want %q", user, "alice@example.com")
+		}
+	})
+
+	t.Run("create and update assignees", func(t *testing.T) {
+		got, err := mr.CreateBean(ctx, model.CreateBeanInput{
+			Title:     "Assigned",
+			Assignees: []string{"alice@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+
+		got, err = mr.UpdateBean(ctx, got.ID, model.UpdateBeanInput{
+			AddAssignees:    []string{"bob"},
+			RemoveAssignees: []string{"alice@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if len(got.Assignees) != 1 || got.Assignees[0] != "bob" {
+			t.Errorf("Assignees = %v, want [bob]", got.Assignees)
+		}
+
+		_, err = mr.UpdateBean(ctx, got.ID, model.UpdateBeanInput{
+			Assignees:    []string{"carol"},
+			AddAssignees: []string{"dave"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "cannot specify both") {
+			t.Errorf("UpdateBean() error = %v, want mutual exclusion error", err)
+		}
+	})
+
+	t.Run("filter by assignee", func(t *testing.T) {
+		createTestBean(t, core, "asg-1", "Unassigned", "todo")
+
+		beans, err := qr.Beans(ctx, &model.BeanFilter{Assignee: []string{"BOB"}})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 1 || beans[0].Title != "Assigned" {
+			t.Errorf("Beans(assignee=bob) = %d beans, want the Assigned bean", len(beans))
+		}
+
+		unassigned := true
+		beans, err = qr.Beans(ctx, &model.BeanFilter{Unassigned: &unassigned})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 1 || beans[0].ID != "asg-1" {
+			t.Errorf("Beans(unassigned) = %d beans, want asg-1", len(beans))
+		}
+	})
+}
+
+func TestDueDates(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+
+	past := "2020-01-15"
+	future := time.Now().AddDate(1, 0, 0).Format(bean.DateFormat)
+	create := func(title, status, due string) *bean.Bean {
+		t.Helper()
+		b, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: title, Status: &status, DueAt: &due})
+		if err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		return b
+	}
+	late := create("Late", "todo", past)
+	create("Done late", "completed", past)
+	create("Upcoming", "todo", future)
+
+	t.Run("invalid dates are rejected", func(t *testing.T) {
+		bad := "next tuesday"
+		_, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Bad", DueAt: &bad})
+		if err == nil || !strings.Contains(err.Error(), "invalid date") {
+			t.Errorf("CreateBean() error = %v, want invalid date error", err)
+		}
+	})
+
+	t.Run("overdue", func(t *testing.T) {
+		overdue := true
+		beans, err := qr.Beans(ctx, &model.BeanFilter{Overdue: &overdue})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 1 || beans[0].ID != late.ID {
+			t.Errorf("Beans(overdue) = %d beans, want only %s", len(beans), late.ID)
+		}
+		isOverdue, _ := resolver.Bean().IsOverdue(ctx, late)
+		if !isOverdue {
+			t.Error("IsOverdue() = false, want true")
+		}
+	})
+
+	t.Run("due before and after", func(t *testing.T) {
+		cutoff := time.Now()
+		beans, err := qr.Beans(ctx, &model.BeanFilter{DueBefore: &cutoff})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 2 {
+			t.Errorf("Beans(dueBefore) = %d beans, want 2", len(beans))
+		}
+		beans, err = qr.Beans(ctx, &model.BeanFilter{DueAfter: &cutoff})
+		if err != nil {
+			t.Fatalf("Beans() error = %v", err)
+		}
+		if len(beans) != 1 || beans[0].Title != "Upcoming" {
+			t.Errorf("Beans(dueAfter) = %d beans, want Upcoming", len(beans))
+		}
+	})
+
+	t.Run("clear due date", func(t *testing.T) {
+		empty := ""
+		got, err := mr.UpdateBean(ctx, late.ID, model.UpdateBeanInput{DueAt: &empty})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if got.DueAt != nil {
+			t.Errorf("DueAt = %v, want nil", got.DueAt)
+		}
+	})
+}
+
+func TestEstimateRollups(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	create := func(title, typ, status, parent string, estimate float64) *bean.Bean {
+		t.Helper()
+		input := model.CreateBeanInput{Title: title, Type: &typ, Status: &status, Estimate: &estimate}
+		if parent != "" {
+			input.Parent = &parent
+		}
+		b, err := mr.CreateBean(ctx, input)
+		if err != nil {
+			t.Fatalf("CreateBean(%s) error = %v", title, err)
+		}
+		return b
+	}
+	milestone := create("v1", "milestone", "todo", "", 0)
+	epic := create("Auth", "epic", "todo", milestone.ID, 0)
+	create("Login", "task", "completed", epic.ID, 3)
+	create("Logout", "task", "todo", epic.ID, 2)
+	create("SSO", "task", "scrapped", epic.ID, 8)
+	create("Release notes", "task", "todo", milestone.ID, 1.5)
+
+	tests := []struct {
+		bean      *bean.Bean
+		wantTotal float64
+		wantDone  float64
+	}{
+		{milestone, 6.5, 3},
+		{epic, 5, 3},
+	}
+	for _, tt := range tests {
+		total, err := resolver.Bean().TotalEstimate(ctx, tt.bean)
+		if err != nil {
+			t.Fatalf("TotalEstimate() error = %v", err)
+		}
+		done, err := resolver.Bean().CompletedEstimate(ctx, tt.bean)
+		if err != nil {
+			t.Fatalf("CompletedEstimate() error = %v", err)
+		}
+		if total != tt.wantTotal || done != tt.wantDone {
+			t.Errorf("%s rollup = %v/%v, want %v/%v", tt.bean.Title, done, total, tt.wantDone, tt.wantTotal)
+		}
+	}
+
+	t.Run("negative estimates are rejected", func(t *testing.T) {
+		negative := -1.0
+		_, err := mr.UpdateBean(ctx, epic.ID, model.UpdateBeanInput{Estimate: &negative})
+		if err == nil || !strings.Contains(err.Error(), "cannot be negative") {
+			t.Errorf("UpdateBean() error = %v, want negative estimate error", err)
+		}
+	})
+}
+
+func TestAddComment(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	t.Setenv("BEANS_USER", "alice@example.com")
+	createTestBean(t, core, "cmt-1", "Discussed", "todo")
+
+	got, err := mr.AddComment(ctx, "cmt-1", "First thoughts", nil, nil)
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	bob := "bob"
+	got, err = mr.AddComment(ctx, "cmt-1", "Agreed", &bob, nil)
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+
+	if len(got.Comments) != 2 {
+		t.Fatalf("Comments = %+v, want 2", got.Comments)
+	}
+	if got.Comments[0].Author != "alice@example.com" || got.Comments[0].Text != "First thoughts" {
+		t.Errorf("Comments[0] = %+v, want current user's comment", got.Comments[0])
+	}
+	if got.Comments[1].Author != "bob" {
+		t.Errorf("Comments[1].Author = %q, want bob", got.Comments[1].Author)
+	}
+	if got.Body != "" {
+		t.Errorf("Body = %q, comments should not touch the body", got.Body)
+	}
+
+	// Comments are persisted in the bean file
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	reloaded, err := core.Get("cmt-1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(reloaded.Comments) != 2 {
+		t.Errorf("reloaded Comments = %+v, want 2", reloaded.Comments)
+	}
+
+	if _, err := mr.AddComment(ctx, "cmt-1", "   ", nil, nil); err == nil {
+		t.Error("AddComment() with empty text should fail")
+	}
+	if _, err := mr.AddComment(ctx, "nope", "Hello", nil, nil); err == nil {
+		t.Error("AddComment() on missing bean should fail")
+	}
+}
+
+func TestTypedLinks(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+	qr := resolver.Query()
+	br := resolver.Bean()
+
+	original := createTestBean(t, core, "lnk-1", "Original", "todo")
+	dup := createTestBean(t, core, "lnk-2", "Duplicate", "todo")
+	feature := createTestBean(t, core, "lnk-3", "Feature", "completed")
+
+	t.Run("add links", func(t *testing.T) {
+		if _, err := mr.AddLink(ctx, dup.ID, "duplicates", original.ID, nil); err != nil {
+			t.Fatalf("AddLink() error = %v", err)
+		}
+		if _, err := mr.UpdateBean(ctx, original.ID, model.UpdateBeanInput{
+			AddLinks: []*model.LinkInput{
+				{Type: "caused_by", TargetID: feature.ID},
+				{Type: "relates_to", TargetID: dup.ID},
+			},
+		}); err != nil {
+			t.Fatalf("UpdateBean(addLinks) error = %v", err)
+		}
+
+		links, _ := br.Links(ctx, original)
+		if len(links) != 2 || links[0].Type != "caused_by" || links[1].Type != "relates_to" {
+			t.Errorf("Links() = %+v, want caused_by and relates_to", links)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := mr.AddLink(ctx, original.ID, "duplicates", dup.ID, nil); err == nil || !strings.Contains(err.Error(), "cycle") {
+			t.Errorf("AddLink() error = %v, want cycle error", err)
+		}
+		if _, err := mr.AddLink(ctx, original.ID, "follows", dup.ID, nil); err == nil || !strings.Contains(err.Error(), "unknown link type") {
+			t.Errorf("AddLink() error = %v, want unknown link type", err)
+		}
+		if _, err := mr.AddLink(ctx, original.ID, "relates_to", "nope", nil); err == nil {
+			t.Error("AddLink() to a missing bean should fail")
+		}
+	})
+
+	t.Run("inverse fields", func(t *testing.T) {
+		duplicatedBy, _ := br.DuplicatedBy(ctx, original, nil)
+		if len(duplicatedBy) != 1 || duplicatedBy[0].ID != dup.ID {
+			t.Errorf("DuplicatedBy() = %v, want [%s]", duplicatedBy, dup.ID)
+		}
+		causes, _ := br.Causes(ctx, feature, nil)
+		if len(causes) != 1 || causes[0].ID != original.ID {
+			t.Errorf("Causes() = %v, want [%s]", causes, original.ID)
+		}
+		// relates_to is symmetric
+		related, _ := br.RelatesTo(ctx, dup, nil)
+		if len(related) != 1 || related[0].ID != original.ID {
+			t.Errorf("RelatesTo() = %v, want [%s]", related, original.ID)
+		}
+		inverse := true
+		linked, _ := br.Linked(ctx, feature, "caused_by", &inverse, nil)
+		if len(linked) != 1 || linked[0].ID != original.ID {
+			t.Errorf("Linked(caused_by, inverse) = %v, want [%s]", linked, original.ID)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		beans, _ := qr.Beans(ctx, &model.BeanFilter{HasLinks: []*model.LinkFilter{{Type: "duplicates"}}})
+		if len(beans) != 1 || beans[0].ID != dup.ID {
+			t.Errorf("Beans(hasLinks duplicates) = %v, want [%s]", beans, dup.ID)
+		}
+		featureID := feature.ID
+		beans, _ = qr.Beans(ctx, &model.BeanFilter{HasLinks: []*model.LinkFilter{{Type: "caused_by", ID: &featureID}}})
+		if len(beans) != 1 || beans[0].ID != original.ID {
+			t.Errorf("Beans(hasLinks caused_by) = %v, want [%s]", beans, original.ID)
+		}
+		beans, _ = qr.Beans(ctx, &model.BeanFilter{LinkedFrom: []*model.LinkFilter{{Type: "duplicates"}}})
+		if len(beans) != 1 || beans[0].ID != original.ID {
+			t.Errorf("Beans(linkedFrom duplicates) = %v, want [%s]", beans, original.ID)
+		}
+		beans, _ = qr.Beans(ctx, &model.BeanFilter{NoLinks: []string{"relates_to"}})
+		if len(beans) != 1 || beans[0].ID != feature.ID {
+			t.Errorf("Beans(noLinks relates_to) = %v, want [%s]", beans, feature.ID)
+		}
+	})
+
+	t.Run("remove and delete", func(t *testing.T) {
+		if _, err := mr.RemoveLink(ctx, original.ID, "relates_to", dup.ID, nil); err != nil {
+			t.Fatalf("RemoveLink() error = %v", err)
+		}
+		if original.HasLink("relates_to", dup.ID) {
+			t.Error("relates_to link should be removed")
+		}
+
+		if _, err := mr.DeleteBean(ctx, feature.ID); err != nil {
+			t.Fatalf("DeleteBean() error = %v", err)
+		}
+		if len(original.Links) != 0 {
+			t.Errorf("Links = %v, incoming links to deleted bean should be removed", original.Links)
+		}
+	})
+}
+
+func TestMergeBean(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	keep := createTestBean(t, core, "mrg-1", "Login fails", "todo")
+	dup := createTestBean(t, core, "mrg-2", "Cannot log in", "todo")
+	other := createTestBean(t, core, "mrg-3", "Session handling", "todo")
+	if _, err := mr.AddBlocking(ctx, other.ID, dup.ID, nil); err != nil {
+		t.Fatalf("AddBlocking() error = %v", err)
+	}
+
+	result, err := mr.MergeBean(ctx, dup.ID, keep.ID)
+	if err != nil {
+		t.Fatalf("MergeBean() error = %v", err)
+	}
+	if result.Into.ID != keep.ID || result.Duplicate.Status != "scrapped" || result.LinksRewritten != 1 {
+		t.Errorf("MergeBean() = %+v, want scrapped duplicate and one rewritten link", result)
+	}
+	if !other.IsBlocking(keep.ID) || other.IsBlocking(dup.ID) {
+		t.Errorf("other.Blocking = %v, want [%s]", other.Blocking, keep.ID)
+	}
+
+	if _, err := mr.MergeBean(ctx, "nope", keep.ID); err == nil {
+		t.Error("MergeBean() with a missing duplicate should fail")
+	}
+}
+
+func TestUpdateBeans(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	for _, id := range []string{"bulk-1", "bulk-2"} {
+		b := createTestBean(t, core, id, "Auth bug "+id, "todo")
+		b.Tags = []string{"auth"}
+		if err := core.Update(b, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	createTestBean(t, core, "bulk-3", "Untagged", "in-progress")
+
+	filter := model.BeanFilter{Tags: []string{"auth"}}
+	deferred := "deferred"
+
+	t.Run("dry run", func(t *testing.T) {
+		dryRun := true
+		beans, err := mr.UpdateBeans(ctx, filter, model.UpdateBeanInput{Priority: &deferred}, &dryRun)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if len(beans) != 2 || beans[0].Priority != "deferred" {
+			t.Errorf("UpdateBeans(dryRun) = %v, want two deferred beans", beans)
+		}
+		if b, _ := core.Get("bulk-1"); b.Priority != "" {
+			t.Errorf("dry run wrote priority %q", b.Priority)
+		}
+	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		core.Config().Transitions = map[string][]string{"todo": {"in-progress"}}
+		defer func() { core.Config().Transitions = nil }()
+
+		completed := "completed"
+		all := model.BeanFilter{}
+		if _, err := mr.UpdateBeans(ctx, all, model.UpdateBeanInput{Status: &completed}, nil); err == nil {
+			t.Fatal("UpdateBeans() error = nil, want transition error for todo beans")
+		}
+		if b, _ := core.Get("bulk-3"); b.Status != "in-progress" {
+			t.Errorf("bulk-3 status = %q, want unchanged after failed bulk update", b.Status)
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		beans, err := mr.UpdateBeans(ctx, filter, model.UpdateBeanInput{Priority: &deferred, AddTags: []string{"triaged"}}, nil)
+		if err != nil {
+			t.Fatalf("UpdateBeans() error = %v", err)
+		}
+		if len(beans) != 2 {
+			t.Fatalf("UpdateBeans() updated %d beans, want 2", len(beans))
+		}
+		for _, id := range []string{"bulk-1", "bulk-2"} {
+			if b, _ := core.Get(id); b.Priority != "deferred" || !b.HasTag("triaged") {
+				t.Errorf("%s = priority %q, tags %v; want deferred and triaged", id, b.Priority, b.Tags)
+			}
+		}
+		if b, _ := core.Get("bulk-3"); b.Priority != "" {
+			t.Errorf("bulk-3 priority = %q, want unchanged", b.Priority)
+		}
+	})
+}
+
+func TestBatch(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	existing := createTestBean(t, core, "bat-1", "Existing", "todo")
+	ref := func(s string) *string { return &s }
+
+	t.Run("creates related beans atomically", func(t *testing.T) {
+		results, err := mr.Batch(ctx, []*model.BatchOperation{
+			{Ref: ref("epic"), Create: &model.CreateBeanInput{Title: "Auth epic", Type: ref("epic")}},
+			{Ref: ref("login"), Create: &model.CreateBeanInput{Title: "Login form", Parent: ref("$epic")}},
+			{Create: &model.CreateBeanInput{Title: "Logout", Parent: ref("$epic"), BlockedBy: []string{"$login"}}},
+			{AddLink: &model.BatchLink{ID: existing.ID, Type: "relates_to", TargetID: "$epic"}},
+		})
+		if err != nil {
+			t.Fatalf("Batch() error = %v", err)
+		}
+		if len(results) != 4 {
+			t.Fatalf("Batch() returned %d results, want 4", len(results))
+		}
+		epic, login, logout := results[0], results[1], results[2]
+		if login.Parent != epic.ID || logout.Parent != epic.ID || !logout.IsBlockedBy(login.ID) {
+			t.Errorf("refs not resolved: login.Parent = %q, logout = parent %q, blocked_by %v", login.Parent, logout.Parent, logout.BlockedBy)
+		}
+		if b, _ := core.Get(existing.ID); !b.HasLink("relates_to", epic.ID) {
+			t.Errorf("existing.Links = %v, want relates_to %s", b.Links, epic.ID)
+		}
+		if _, err := os.Stat(filepath.Join(core.Root(), logout.Path)); err != nil {
+			t.Errorf("batch bean not written: %v", err)
+		}
+	})
+
+	t.Run("failed operation writes nothing", func(t *testing.T) {
+		before := len(core.All())
+		_, err := mr.Batch(ctx, []*model.BatchOperation{
+			{Ref: ref("new"), Create: &model.CreateBeanInput{Title: "Never written"}},
+			{Update: &model.BatchUpdate{ID: existing.ID, Input: &model.UpdateBeanInput{Title: ref("Renamed")}}},
+			{Update: &model.BatchUpdate{ID: "$new", Input: &model.UpdateBeanInput{AddBlocking: []string{"nope"}}}},
+		})
+		if err == nil || !strings.HasPrefix(err.Error(), "operations[2]:") {
+			t.Fatalf("Batch() error = %v, want failure in operations[2]", err)
+		}
+		if after := len(core.All()); after != before {
+			t.Errorf("bean count = %d after failed batch, want %d", after, before)
+		}
+		if b, _ := core.Get(existing.ID); b.Title != "Existing" {
+			t.Errorf("existing.Title = %q, want unchanged", b.Title)
+		}
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		_, err := mr.Batch(ctx, []*model.BatchOperation{
+			{Delete: ref("$missing")},
+		})
+		if err == nil || !strings.Contains(err.Error(), "unknown ref $missing") {
+			t.Errorf("Batch() error = %v, want unknown ref", err)
+		}
+	})
+}
+
+func TestViews(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	for _, b := range []*bean.Bean{
+		{ID: "view-1", Title: "Old draft", Status: "draft", Tags: []string{"bug"}},
+		{ID: "view-2", Title: "New draft", Status: "draft", Tags: []string{"bug"}},
+		{ID: "view-3", Title: "Ready", Status: "todo", Tags: []string{"bug"}},
+		{ID: "view-4", Title: "Untagged draft", Status: "draft"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+	core.Config().Views = []config.ViewConfig{
+		{Name: "triage", Filter: map[string]any{"status": []any{"draft"}, "tags": []any{"bug"}}, Sort: "id"},
+		{Name: "everything"},
+		{Name: "broken", Filter: map[string]any{"stauts": []any{"draft"}}},
+	}
+
+	views, err := resolver.Query().Views(ctx)
+	if err != nil {
+		t.Fatalf("Views() error = %v", err)
+	}
+	if len(views) != 3 || views[0].Name != "triage" {
+		t.Fatalf("Views() = %v, want the three configured views", views)
+	}
+	if views[1].Filter == nil {
+		t.Error("view without a filter returned a nil filter")
+	}
+
+	beans, err := resolver.View().Beans(ctx, views[0])
+	if err != nil {
+		t.Fatalf("View.beans error = %v", err)
+	}
+	if len(beans) != 2 || beans[0].ID != "view-1" || beans[1].ID != "view-2" {
+		t.Errorf("triage beans = %v, want view-1 and view-2 in id order", beans)
+	}
+	if beans, _ := resolver.View().Beans(ctx, views[1]); len(beans) != 4 {
+		t.Errorf("everything returned %d beans, want 4", len(beans))
+	}
+	if _, err := resolver.View().Beans(ctx, views[2]); err == nil || !strings.Contains(err.Error(), "stauts") {
+		t.Errorf("View.beans error = %v, want unknown filter key", err)
+	}
+
+	// Explicit filters override the view's
+	base, _ := resolver.ViewFilter(views[0])
+	merged, err := beangraph.MergeFilters(base, &model.BeanFilter{Status: []string{"todo"}})
+	if err != nil {
+		t.Fatalf("MergeFilters() error = %v", err)
+	}
+	if len(merged.Status) != 1 || merged.Status[0] != "todo" || len(merged.Tags) != 1 {
+		t.Errorf("MergeFilters() = status %v, tags %v; want status [todo] and the view's tags", merged.Status, merged.Tags)
+	}
+}
+
+func TestQueryBeansWhere(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	qr := resolver.Query()
+
+	due := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, b := range []*bean.Bean{
+		{ID: "epic", Title: "Auth epic", Status: "todo", Type: "epic"},
+		{ID: "crit", Title: "Login crash", Status: "todo", Type: "bug", Priority: "critical", Parent: "epic"},
+		{ID: "lowbug", Title: "Typo", Status: "todo", Type: "bug", Priority: "low"},
+		{ID: "sec", Title: "Two-factor login", Status: "draft", Type: "feature", Tags: []string{"security"}, DueAt: &due, Estimate: 5},
+		{ID: "done", Title: "Old feature", Status: "completed", Type: "feature", Tags: []string{"security"}, BlockedBy: []string{"lowbug"}},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	tests := []struct {
+		where string
+		want  []string
+	}{
+		{"(type = bug AND priority >= high) OR (type = feature AND tags = security) AND NOT status = completed", []string{"crit", "sec"}},
+		{"parent.type = epic", []string{"crit"}},
+		{"children.priority = critical", []string{"epic"}},
+		{"blocked_by.type = bug", []string{"done"}},
+		{"priority < normal", []string{"lowbug"}},
+		{"status in (draft, completed) AND title ~ FEATURE", []string{"done"}},
+		{"due < 2025-06-02 AND due >= 2025-06-01 AND due = 2025-06-01", []string{"sec"}},
+		{"estimate > 3", []string{"sec"}},
+		{"tags AND NOT due", []string{"done"}},
+		{"parent", []string{"crit"}},
+		{"archived = false AND type = epic", []string{"epic"}},
+	}
+	for _, tt := range tests {
+		where := tt.where
+		got, err := qr.Beans(ctx, &model.BeanFilter{Where: &where})
+		if err != nil {
+			t.Errorf("where %q error = %v", tt.where, err)
+			continue
+		}
+		var ids []string
+		for _, b := range got {
+			ids = append(ids, b.ID)
+		}
+		slices.Sort(ids)
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("where %q = %v, want %v", tt.where, ids, tt.want)
+		}
+	}
+
+	// Combined with the other filters
+	where := "type = bug"
+	got, err := qr.Beans(ctx, &model.BeanFilter{Where: &where, Priority: []string{"low"}})
+	if err != nil || len(got) != 1 || got[0].ID != "lowbug" {
+		t.Errorf("where with priority filter = %v, %v; want lowbug", got, err)
+	}
+
+	for where, want := range map[string]string{
+		"stauts = todo":   "unknown field 'stauts'",
+		"status = tood":   "invalid status 'tood'",
+		"owner.type = x":  "unknown relationship 'owner'",
+		"due < someday":   "invalid date 'someday'",
+		"title > a":       "title cannot be compared with >",
+		"estimate = lots": "estimate must be compared with a number",
+		"type = bug AND":  "invalid query at position 15",
+	} {
+		if _, err := qr.Beans(ctx, &model.BeanFilter{Where: &where}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("where %q error = %v, want %q", where, err, want)
+		}
+	}
+}
+
+func TestQuerySearchBeans(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	for _, b := range []*bean.Bean{
+		{ID: "search-1", Title: "Token refresh", Body: "Refresh the session token before it expires.", Tags: []string{"auth"}},
+		{ID: "search-2", Title: "Session storage", Body: "Store the session in Redis."},
+		{ID: "search-3", Title: "Unrelated", Body: "Nothing to see here."},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	hits, err := resolver.Query().SearchBeans(ctx, "session", nil)
+	if err != nil {
+		t.Fatalf("SearchBeans() error = %v", err)
+	}
+	if len(hits) != 2 || hits[0].Bean.ID != "search-2" || hits[0].Score < hits[1].Score {
+		t.Fatalf("SearchBeans(session) = %v, want search-2 then search-1", hits)
+	}
+	fields := make([]string, len(hits[0].Highlights))
+	for i, h := range hits[0].Highlights {
+		fields[i] = h.Field
+	}
+	if strings.Join(fields, ",") != "body,title" {
+		t.Errorf("highlighted fields = %v, want body and title in order", fields)
+	}
+	if got := hits[1].Highlights[0].Fragments[0]; got != "Refresh the **session** token before it expires." {
+		t.Errorf("body fragment = %q", got)
+	}
+
+	limit := 1
+	if hits, _ := resolver.Query().SearchBeans(ctx, "session", &limit); len(hits) != 1 {
+		t.Errorf("SearchBeans(limit: 1) returned %d hits", len(hits))
+	}
+	if hits, _ := resolver.Query().SearchBeans(ctx, "tags:auth", nil); len(hits) != 1 || hits[0].Bean.ID != "search-1" {
+		t.Errorf("SearchBeans(tags:auth) = %v, want search-1", hits)
+	}
+	if _, err := resolver.Query().SearchBeans(ctx, "title:\"unterminated", nil); err == nil {
+		t.Error("SearchBeans() with an invalid query: error = nil")
+	}
+}
+
+func TestBeanSimilar(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	for _, b := range []*bean.Bean{
+		{ID: "sim-1", Title: "Login broken", Body: "The login form shows an auth error after submitting the password"},
+		{ID: "sim-2", Title: "Auth failure", Body: "Users get an auth error and cannot sign in with their password"},
+		{ID: "sim-3", Title: "Database migration", Body: "Add a migration for the orders table and its index"},
+		{ID: "sim-4", Title: "Slow migration", Body: "The orders table migration rebuilds the index too slowly"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	b, _ := core.Get("sim-1")
+	similar, err := resolver.Bean().Similar(ctx, b, nil)
+	if err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	if len(similar) == 0 || similar[0].Bean.ID != "sim-2" {
+		t.Fatalf("Similar(sim-1) = %v, want sim-2 first", similar)
+	}
+	for i := 1; i < len(similar); i++ {
+		if similar[i].Score > similar[i-1].Score {
+			t.Errorf("Similar() not sorted by score: %v", similar)
+		}
+	}
+
+	limit := 1
+	if similar, _ := resolver.Bean().Similar(ctx, b, &limit); len(similar) != 1 {
+		t.Errorf("Similar(limit: 1) returned %d beans", len(similar))
+	}
+}
+
+// fakeForge is a forge provider whose branch "feature" has an open,
+// mergeable PR #7. It records the PR actions it is asked to run.
+type fakeForge struct {
+	forge.Provider
+	pr      *forge.PullRequest
+	actions []string
+}
+
+func newFakeForge() *fakeForge {
+	return &fakeForge{pr: &forge.PullRequest{Number: 7, Title: "Feature", State: "open", Checks: forge.CheckStatusPass, ReviewApproved: true, Mergeable: true}}
+}
+
+func (f *fakeForge) CLIName() string { return "gh" }
+
+func (f *fakeForge) FindPR(ctx context.Context, repoDir, branch string) (*forge.PullRequest, error) {
+	prs, err := f.FindPRs(ctx, repoDir, []string{branch})
+	if pr := prs[branch]; pr != nil && pr.State == "open" {
+		return pr, err
+	}
+	return nil, err
+}
+
+func (f *fakeForge) FindPRs(ctx context.Context, repoDir string, branches []string) (map[string]*forge.PullRequest, error) {
+	prs := map[string]*forge.PullRequest{}
+	if slices.Contains(branches, "feature") {
+		prs["feature"] = f.pr
+	}
+	return prs, nil
+}
+
+func (f *fakeForge) MergePR(ctx context.Context, repoDir string, number int, method forge.MergeMethod) error {
+	f.actions = append(f.actions, fmt.Sprintf("merge %d %q", number, method))
+	f.pr.State = "merged"
+	return nil
+}
+
+func (f *fakeForge) ClosePR(ctx context.Context, repoDir string, number int) error {
+	f.actions = append(f.actions, fmt.Sprintf("close %d", number))
+	f.pr.State = "closed"
+	return nil
+}
+
+func (f *fakeForge) RequestReview(ctx context.Context, repoDir string, number int, reviewers []string) error {
+	f.actions = append(f.actions, fmt.Sprintf("review %d %v", number, reviewers))
+	return nil
+}
+
+func (f *fakeForge) RerunFailedChecks(ctx context.Context, repoDir string, number int) error {
+	f.actions = append(f.actions, fmt.Sprintf("rerun %d", number))
+	f.pr.Checks = forge.CheckStatusPending
+	return nil
+}
+
+// setupForgeResolver returns a resolver whose project root is a git
+// repository on the given branch, with a fake forge.
+func setupForgeResolver(t *testing.T, branch string) (*mutationResolver, *fakeForge) {
+	t.Helper()
+	resolver, _ := setupTestResolver(t)
+	resolver.ProjectRoot = t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-b", branch},
+		{"-c", "user.email=test@test.com", "-c", "user.name=Test", "commit", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = resolver.ProjectRoot
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+	f := newFakeForge()
+	resolver.Forge = f
+	return &mutationResolver{resolver}, f
+}
+
+func TestPullRequestMutations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merge", func(t *testing.T) {
+		mr, f := setupForgeResolver(t, "feature")
+		method := model.MergeMethodSquash
+		pr, err := mr.MergePullRequest(ctx, CentralSessionID, &method)
+		if err != nil {
+			t.Fatalf("MergePullRequest() error = %v", err)
+		}
+		if pr == nil || pr.State != "merged" {
+			t.Errorf("MergePullRequest() = %+v, want the merged PR", pr)
+		}
+		if !slices.Equal(f.actions, []string{`merge 7 "squash"`}) {
+			t.Errorf("forge actions = %q", f.actions)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		mr, f := setupForgeResolver(t, "feature")
+		if ok, err := mr.ClosePullRequest(ctx, CentralSessionID); err != nil || !ok {
+			t.Fatalf("ClosePullRequest() = %v, %v", ok, err)
+		}
+		if !slices.Equal(f.actions, []string{"close 7"}) {
+			t.Errorf("forge actions = %q", f.actions)
+		}
+		// A closed PR can't be acted on anymore
+		if _, err := mr.ClosePullRequest(ctx, CentralSessionID); err == nil || !strings.Contains(err.Error(), "no open pull request for branch feature") {
+			t.Errorf("second ClosePullRequest() error = %v", err)
+		}
+	})
+
+	t.Run("request review and rerun checks", func(t *testing.T) {
+		mr, f := setupForgeResolver(t, "feature")
+		if _, err := mr.RequestPullRequestReview(ctx, CentralSessionID, nil); err == nil {
+			t.Error("RequestPullRequestReview() without reviewers: expected error")
+		}
+		if _, err := mr.RequestPullRequestReview(ctx, CentralSessionID, []string{"alice", "bob"}); err != nil {
+			t.Fatalf("RequestPullRequestReview() error = %v", err)
+		}
+		pr, err := mr.RerunFailedChecks(ctx, CentralSessionID)
+		if err != nil {
+			t.Fatalf("RerunFailedChecks() error = %v", err)
+		}
+		if pr == nil || pr.CheckStatus != "pending" {
+			t.Errorf("RerunFailedChecks() = %+v, want pending checks", pr)
+		}
+		if !slices.Equal(f.actions, []string{"review 7 [alice bob]", "rerun 7"}) {
+			t.Errorf("forge actions = %q", f.actions)
+		}
+	})
+
+	t.Run("no PR for branch", func(t *testing.T) {
+		mr, f := setupForgeResolver(t, "main")
+		if _, err := mr.MergePullRequest(ctx, CentralSessionID, nil); err == nil || !strings.Contains(err.Error(), "no open pull request for branch main") {
+			t.Errorf("MergePullRequest() error = %v", err)
+		}
+		if len(f.actions) != 0 {
+			t.Errorf("forge actions = %q, want none", f.actions)
+		}
+	})
+
+	t.Run("no forge", func(t *testing.T) {
+		mr, _ := setupForgeResolver(t, "feature")
+		mr.Forge = nil
+		if _, err := mr.RerunFailedChecks(ctx, CentralSessionID); err == nil {
+			t.Error("RerunFailedChecks() without a forge: expected error")
+		}
+	})
+
+	t.Run("PR action merges a mergeable PR without the agent", func(t *testing.T) {
+		mr, f := setupForgeResolver(t, "feature")
+		mr.AgentMgr = agent.NewManager("", nil)
+		if ok, err := mr.ExecuteAgentAction(ctx, CentralSessionID, "create-pr"); err != nil || !ok {
+			t.Fatalf("ExecuteAgentAction() = %v, %v", ok, err)
+		}
+		if !slices.Equal(f.actions, []string{`merge 7 ""`}) {
+			t.Errorf("forge actions = %q, want the PR merged with the default method", f.actions)
+		}
+		if session := mr.AgentMgr.GetSession(CentralSessionID); session != nil && len(session.Messages) > 0 {
+			t.Errorf("agent was sent %d messages, want none", len(session.Messages))
+		}
+	})
+}
@@ -0,0 +1,297 @@
+// Package issues imports issues from git forges as beans, and keeps beans
+// and issues in sync.
+package issues
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/pkg/forge"
+)
+
+// Options configure an import.
+type Options struct {
+	// Repo is the owner/repo the issues belong to. It is only used to build
+	// source references for issues exported without their URL.
+	Repo string
+	// DryRun reports what an import would do without writing anything.
+	DryRun bool
+}
+
+// Result lists the beans an import created, updated or left unchanged.
+type Result struct {
+	Created   []*bean.Bean `json:"created"`
+	Updated   []*bean.Bean `json:"updated"`
+	Unchanged []*bean.Bean `json:"unchanged"`
+	// Warnings reports labels that could not be imported.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// typeLabelPrefixes are label prefixes commonly used to mark an issue's type,
+// e.g. "type: bug" or "kind/feature".
+var typeLabelPrefixes = []string{"type:", "type/", "kind:", "kind/"}
+
+// typeLabelAliases maps label names used by GitHub's default labels to the
+// built-in bean types.
+var typeLabelAliases = map[string]string{
+	"enhancement":     "feature",
+	"feature request": "feature",
+}
+
+// ImportGitHub imports GitHub issues as beans:
+//   - labels naming a bean type (e.g. "bug", "type: feature", "enhancement")
+//     set the type, and the other labels become tags;
+//   - milestones become milestone beans, parents of their issues;
+//   - open issues get the default status, closed ones completed, or scrapped
+//     if closed as not planned;
+//   - the issue body becomes the bean body.
+//
+// Each bean records its issue's URL as its source. Importing an issue again
+// updates its bean instead of creating another one. Local workflow progress
+// is kept: the status only changes when the issue was closed or reopened.
+// All changes are written at once, or none if any fails.
+func ImportGitHub(core *beancore.Core, issues []forge.Issue, opts Options) (*Result, error) {
+	tx := core.Begin()
+	defer tx.Rollback()
+
+	im := &importer{
+		core:     tx.Core(),
+		cfg:      core.Config(),
+		bySource: make(map[string]*bean.Bean),
+		done:     make(map[string]*bean.Bean),
+		result:   &Result{Created: []*bean.Bean{}, Updated: []*bean.Bean{}, Unchanged: []*bean.Bean{}},
+	}
+	for _, b := range im.core.All() {
+		if b.Source != "" {
+			im.bySource[b.Source] = b
+		}
+	}
+
+	for _, issue := range issues {
+		if err := im.importIssue(issue, opts.Repo); err != nil {
+			return nil, fmt.Errorf("issue #%d: %w", issue.Number, err)
+		}
+	}
+
+	if !opts.DryRun {
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+	}
+	return im.result, nil
+}
+
+// importer holds the state of one import.
+type importer struct {
+	core     *beancore.Core
+	cfg      *config.Config
+	bySource map[string]*bean.Bean
+	done     map[string]*bean.Bean // beans handled by this import, by source
+	result   *Result
+}
+
+// importIssue creates or updates the bean of an issue, and of its milestone.
+func (im *importer) importIssue(issue forge.Issue, repo string) error {
+	source := issue.URL
+	if source == "" {
+		if repo == "" {
+			return fmt.Errorf("no url to reference it by (include url in `gh issue list --json`, or pass the repository)")
+		}
+		source = fmt.Sprintf("https://github.com/%s/issues/%d", repo, issue.Number)
+	}
+
+	var parent string
+	if m := issue.Milestone; m != nil {
+		milestone, err := im.importMilestone(*m, forge.GitHubRepoURL(source))
+		if err != nil {
+			return err
+		}
+		parent = milestone.ID
+	}
+
+	issueType, tags := im.mapLabels(issue.Labels)
+	_, err := im.upsert(source, issue.Title, func(b *bean.Bean) {
+		b.Title = issue.Title
+		setBody(b, issue.Body)
+		if issueType != "" {
+			b.Type = issueType
+		} else if b.Type == "" {
+			b.Type = im.cfg.GetDefaultType()
+		}
+		b.Tags = tags
+		setStatus(im.cfg, b, issue)
+		if parent != "" {
+			b.Parent = parent
+		}
+	})
+	return err
+}
+
+// importMilestone creates or updates the milestone bean of a milestone of the
+// repository at repoURL.
+func (im *importer) importMilestone(m forge.Milestone, repoURL string) (*bean.Bean, error) {
+	source := m.URL
+	if source == "" {
+		if repoURL == "" || m.Number == 0 {
+			return nil, fmt.Errorf("milestone %q has no number to reference it by", m.Title)
+		}
+		source = fmt.Sprintf("%s/milestone/%d", repoURL, m.Number)
+	}
+
+	return im.upsert(source, m.Title, func(b *bean.Bean) {
+		b.Title = m.Title
+		setBody(b, m.Description)
+		if im.cfg.IsValidType("milestone") {
+			b.Type = "milestone"
+		} else if b.Type == "" {
+			b.Type = im.cfg.GetDefaultType()
+		}
+		if b.Status == "" {
+			b.Status = im.cfg.GetDefaultStatus()
+		}
+		b.DueAt = m.DueOn
+	})
+}
+
+// upsert applies apply to the bean with the given source, creating it if
+// there is none, and records the outcome. A bean already handled by this
+// import, like the milestone of several issues, is returned as it is.
+func (im *importer) upsert(source, title string, apply func(b *bean.Bean)) (*bean.Bean, error) {
+	if b, ok := im.done[source]; ok {
+		return b, nil
+	}
+
+	existing, ok := im.bySource[source]
+	if !ok {
+		b := &bean.Bean{Slug: bean.Slugify(title), Source: source}
+		apply(b)
+		if err := im.core.Create(b); err != nil {
+			return nil, err
+		}
+		im.done[source] = b
+		im.result.Created = append(im.result.Created, b)
+		return b, nil
+	}
+
+	updated := existing.Clone()
+	apply(updated)
+	if len(bean.Diff(existing, updated)) == 0 {
+		im.done[source] = existing
+		im.result.Unchanged = append(im.result.Unchanged, existing)
+		return existing, nil
+	}
+	// Projects that require an etag get the one the bean had when read
+	var ifMatch *string
+	if im.cfg.Beans.RequireIfMatch {
+		etag := existing.ETag()
+		ifMatch = &etag
+	}
+	if err := im.core.Update(updated, ifMatch); err != nil {
+		return nil, err
+	}
+	im.done[source] = updated
+	im.result.Updated = append(im.result.Updated, updated)
+	return updated, nil
+}
+
+// mapLabels returns the bean type named by labels (empty if none) and the
+// tags for the other labels. Labels that can't be turned into valid tags are
+// skipped with a warning.
+func (im *importer) mapLabels(labels []string) (string, []string) {
+	var beanType string
+	var tags []string
+	for _, label := range labels {
+		if t := im.labelType(label); t != "" && beanType == "" {
+			beanType = t
+			continue
+		}
+		tag := labelTag(label)
+		if err := bean.ValidateTag(tag); err != nil {
+			im.result.Warnings = append(im.result.Warnings, fmt.Sprintf("skipped label %q: not a valid tag", label))
+			continue
+		}
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return beanType, tags
+}
+
+// labelType returns the bean type a label names, or "" if it names none.
+func (im *importer) labelType(label string) string {
+	name := strings.ToLower(strings.TrimSpace(label))
+	for _, prefix := range typeLabelPrefixes {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			name = strings.TrimSpace(rest)
+			break
+		}
+	}
+	if alias, ok := typeLabelAliases[name]; ok {
+		name = alias
+	}
+	// Milestones come from GitHub milestones, not labels
+	if name == "milestone" || !im.cfg.IsValidType(name) {
+		return ""
+	}
+	return name
+}
+
+// setStatus sets b's status from its issue's state. Local workflow progress
+// is kept: the status only changes when b has none yet, or when the issue
+// was closed or reopened. Open issues get the default status, closed ones
+// completed, or scrapped if closed as not planned.
+func setStatus(cfg *config.Config, b *bean.Bean, issue forge.Issue) {
+	closed := issue.State == "closed"
+	if b.Status != "" && closed == cfg.IsArchiveStatus(b.Status) {
+		return
+	}
+	switch {
+	case !closed:
+		b.Status = cfg.GetDefaultStatus()
+	case issue.StateReason == "not_planned":
+		b.Status = "scrapped"
+	default:
+		b.Status = "completed"
+	}
+}
+
+// labelTag turns a label into a tag: lowercase, with every run of other
+// characters than letters and digits replaced by a hyphen, e.g.
+// "good first issue" becomes "good-first-issue".
+func labelTag(label string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(label) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return sb.String()
+}
+
+// setBody sets b's body to an issue body with the line endings of bean
+// files. Bodies read from disk start with the blank line after the front
+// matter, so a body that only differs in surrounding whitespace is kept.
+func setBody(b *bean.Bean, body string) {
+	body = normalizeBody(body)
+	if normalizeBody(b.Body) != body {
+		b.Body = body
+	}
+}
+
+// normalizeBody returns body with the line endings of bean files and without
+// surrounding whitespace, for comparing bean and issue bodies.
+func normalizeBody(body string) string {
+	return strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+}
@@ -0,0 +1,196 @@
+package issues
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/pkg/forge"
+)
+
+func setupTestCore(t *testing.T) *beancore.Core {
+	t.Helper()
+	beansDir := filepath.Join(t.TempDir(), beancore.BeansDir)
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create test .beans dir: %v", err)
+	}
+	core := beancore.New(beansDir, config.Default())
+	core.SetWarnWriter(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("failed to load core: %v", err)
+	}
+	return core
+}
+
+func testIssues() []forge.Issue {
+	due := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	v1 := &forge.Milestone{Number: 1, Title: "v1.0", Description: "First release", DueOn: &due, URL: "https://github.com/acme/app/milestone/1"}
+	return []forge.Issue{
+		{Number: 1, Title: "Login broken", Body: "Steps:\r\n1. Log in\r\n", State: "open", URL: "https://github.com/acme/app/issues/1",
+			Labels: []string{"bug", "good first issue", "area/auth"}, Milestone: v1},
+		{Number: 2, Title: "Dark mode", State: "closed", StateReason: "completed", URL: "https://github.com/acme/app/issues/2",
+			Labels: []string{"enhancement", "🎨"}, Milestone: v1},
+		{Number: 3, Title: "Rewrite in Rust", State: "closed", StateReason: "not_planned", URL: "https://github.com/acme/app/issues/3",
+			Labels: []string{"type: task"}},
+	}
+}
+
+// findBySource returns the bean with the given source, failing the test if
+// there is none.
+func findBySource(t *testing.T, core *beancore.Core, source string) *bean.Bean {
+	t.Helper()
+	for _, b := range core.All() {
+		if b.Source == source {
+			return b
+		}
+	}
+	t.Fatalf("no bean with source %s", source)
+	return nil
+}
+
+func TestImportGitHub(t *testing.T) {
+	core := setupTestCore(t)
+
+	result, err := ImportGitHub(core, testIssues(), Options{})
+	if err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+	if len(result.Created) != 4 || len(result.Updated) != 0 || len(result.Unchanged) != 0 {
+		t.Fatalf("result = %d created, %d updated, %d unchanged, want 4 created",
+			len(result.Created), len(result.Updated), len(result.Unchanged))
+	}
+	if len(result.Warnings) != 1 {
+		t.Errorf("Warnings = %v, want one for the emoji label", result.Warnings)
+	}
+
+	milestone := findBySource(t, core, "https://github.com/acme/app/milestone/1")
+	if milestone.Type != "milestone" || milestone.Title != "v1.0" || milestone.Body != "First release" || milestone.DueAt == nil {
+		t.Errorf("milestone = %+v", milestone)
+	}
+
+	login := findBySource(t, core, "https://github.com/acme/app/issues/1")
+	if login.Type != "bug" || login.Status != "todo" || login.Parent != milestone.ID {
+		t.Errorf("issue 1: type %q, status %q, parent %q", login.Type, login.Status, login.Parent)
+	}
+	if !slices.Equal(login.Tags, []string{"good-first-issue", "area-auth"}) {
+		t.Errorf("issue 1 tags = %v", login.Tags)
+	}
+	if login.Body != "Steps:\n1. Log in" {
+		t.Errorf("issue 1 body = %q", login.Body)
+	}
+
+	darkMode := findBySource(t, core, "https://github.com/acme/app/issues/2")
+	if darkMode.Type != "feature" || darkMode.Status != "completed" || darkMode.Parent != milestone.ID {
+		t.Errorf("issue 2: type %q, status %q, parent %q", darkMode.Type, darkMode.Status, darkMode.Parent)
+	}
+
+	rewrite := findBySource(t, core, "https://github.com/acme/app/issues/3")
+	if rewrite.Type != "task" || rewrite.Status != "scrapped" || len(rewrite.Tags) != 0 {
+		t.Errorf("issue 3: type %q, status %q, tags %v", rewrite.Type, rewrite.Status, rewrite.Tags)
+	}
+
+	// The beans were written to disk
+	reloaded := beancore.New(core.Root(), core.Config())
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if n := len(reloaded.All()); n != 4 {
+		t.Errorf("reloaded %d beans, want 4", n)
+	}
+}
+
+func TestImportGitHub_Idempotent(t *testing.T) {
+	core := setupTestCore(t)
+	if _, err := ImportGitHub(core, testIssues(), Options{}); err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+
+	// Re-import into beans read back from disk
+	core = beancore.New(core.Root(), core.Config())
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	result, err := ImportGitHub(core, testIssues(), Options{})
+	if err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+	if len(result.Created) != 0 || len(result.Updated) != 0 || len(result.Unchanged) != 4 {
+		t.Errorf("re-import = %d created, %d updated, %d unchanged, want 4 unchanged",
+			len(result.Created), len(result.Updated), len(result.Unchanged))
+	}
+	if n := len(core.All()); n != 4 {
+		t.Errorf("core has %d beans after re-import, want 4", n)
+	}
+}
+
+func TestImportGitHub_UpdatesChangedIssues(t *testing.T) {
+	core := setupTestCore(t)
+	if _, err := ImportGitHub(core, testIssues(), Options{}); err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+
+	// Local progress on an open issue is kept
+	login := findBySource(t, core, "https://github.com/acme/app/issues/1").Clone()
+	login.Status = "in-progress"
+	if err := core.Update(login, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	issues := testIssues()
+	issues[0].Title = "Login broken on Safari"
+	issues[1].State = "open" // reopened
+	result, err := ImportGitHub(core, issues, Options{})
+	if err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+	if len(result.Updated) != 2 {
+		t.Fatalf("re-import updated %d beans, want 2", len(result.Updated))
+	}
+
+	login = findBySource(t, core, "https://github.com/acme/app/issues/1")
+	if login.Title != "Login broken on Safari" || login.Status != "in-progress" {
+		t.Errorf("issue 1: title %q, status %q, want the new title and in-progress", login.Title, login.Status)
+	}
+	if darkMode := findBySource(t, core, "https://github.com/acme/app/issues/2"); darkMode.Status != "todo" {
+		t.Errorf("reopened issue status = %q, want todo", darkMode.Status)
+	}
+}
+
+func TestImportGitHub_DryRun(t *testing.T) {
+	core := setupTestCore(t)
+
+	result, err := ImportGitHub(core, testIssues(), Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("ImportGitHub() error = %v", err)
+	}
+	if len(result.Created) != 4 {
+		t.Errorf("dry run reported %d created beans, want 4", len(result.Created))
+	}
+	if n := len(core.All()); n != 0 {
+		t.Errorf("dry run left %d beans, want none", n)
+	}
+}
+
+func TestImportGitHub_WithoutURL(t *testing.T) {
+	core := setupTestCore(t)
+	issues := []forge.Issue{{Number: 5, Title: "No URL", State: "open",
+		Milestone: &forge.Milestone{Number: 2, Title: "v2"}}}
+
+	if _, err := ImportGitHub(core, issues, Options{}); err == nil {
+		t.Fatal("ImportGitHub() without URL or repo: error = nil")
+	}
+	if n := len(core.All()); n != 0 {
+		t.Errorf("failed import left %d beans, want none", n)
+	}
+
+	if _, err := ImportGitHub(core, issues, Options{Repo: "acme/app"}); err != nil {
+		t.Fatalf("ImportGitHub() with repo error = %v", err)
+	}
+	findBySource(t, core, "https://github.com/acme/app/issues/5")
+	findBySource(t, core, "https://github.com/acme/app/milestone/2")
+}
@@ -0,0 +1,239 @@
+package issues
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/pkg/forge"
+)
+
+// SyncOptions configure a sync.
+type SyncOptions struct {
+	// RepoDir is the git repository whose forge holds the issues.
+	RepoDir string
+	// DryRun reports what a sync would do without changing beans or issues.
+	DryRun bool
+}
+
+// SyncResult lists the beans a sync mirrored to new issues, pushed to their
+// issues, pulled from their issues, or left unchanged.
+type SyncResult struct {
+	Created   []*bean.Bean `json:"created"`
+	Pushed    []*bean.Bean `json:"pushed"`
+	Pulled    []*bean.Bean `json:"pulled"`
+	Unchanged []*bean.Bean `json:"unchanged"`
+}
+
+// Sync mirrors beans to issues on the provider's forge:
+//   - a bean without an issue gets a new one, whose number and URL are
+//     stored in the bean's issue and source fields;
+//   - when the title, body or open/closed state of a bean and its issue
+//     differ, the one updated last wins: a newer bean is pushed to the issue,
+//     a newer issue is pulled into the bean;
+//   - comments on the issue that the bean doesn't have yet are added to it.
+//
+// Beans imported with ImportGitHub are matched to their issues by source.
+// Each bean is written as soon as it is synced, so that the issues created
+// so far stay linked if a later one fails.
+func Sync(ctx context.Context, core *beancore.Core, provider forge.Provider, beans []*bean.Bean, opts SyncOptions) (*SyncResult, error) {
+	s := &syncer{
+		core:     core,
+		cfg:      core.Config(),
+		provider: provider,
+		opts:     opts,
+		result:   &SyncResult{Created: []*bean.Bean{}, Pushed: []*bean.Bean{}, Pulled: []*bean.Bean{}, Unchanged: []*bean.Bean{}},
+	}
+	for _, b := range beans {
+		if err := s.sync(ctx, b); err != nil {
+			return s.result, fmt.Errorf("bean %s: %w", b.ID, err)
+		}
+	}
+	return s.result, nil
+}
+
+// syncer holds the state of one sync.
+type syncer struct {
+	core     *beancore.Core
+	cfg      *config.Config
+	provider forge.Provider
+	opts     SyncOptions
+	result   *SyncResult
+}
+
+// sync syncs one bean with its issue, creating the issue if there is none.
+func (s *syncer) sync(ctx context.Context, b *bean.Bean) error {
+	number := b.Issue
+	if number == 0 {
+		number = IssueNumber(b.Source)
+	}
+	if number == 0 {
+		return s.create(ctx, b)
+	}
+
+	issue, err := s.provider.GetIssue(ctx, s.opts.RepoDir, number)
+	if err != nil {
+		return err
+	}
+
+	updated := b.Clone()
+	updated.Issue = number
+	pushed := false
+	if s.differs(b, issue) {
+		if b.UpdatedAt == nil || issue.UpdatedAt.After(*b.UpdatedAt) {
+			updated.Title = issue.Title
+			setBody(updated, issue.Body)
+			setStatus(s.cfg, updated, *issue)
+		} else {
+			if err := s.push(ctx, b, issue); err != nil {
+				return err
+			}
+			pushed = true
+		}
+	}
+	addComments(updated, issue.Comments)
+
+	changed := len(bean.Diff(b, updated)) > 0
+	if changed {
+		if err := s.write(b, updated); err != nil {
+			return err
+		}
+	}
+	switch {
+	case pushed:
+		s.result.Pushed = append(s.result.Pushed, updated)
+	case changed:
+		s.result.Pulled = append(s.result.Pulled, updated)
+	default:
+		s.result.Unchanged = append(s.result.Unchanged, b)
+	}
+	return nil
+}
+
+// create mirrors a bean to a new issue and links the bean to it.
+func (s *syncer) create(ctx context.Context, b *bean.Bean) error {
+	updated := b.Clone()
+	if s.opts.DryRun {
+		s.result.Created = append(s.result.Created, updated)
+		return nil
+	}
+
+	issue, err := s.provider.CreateIssue(ctx, s.opts.RepoDir, forge.CreateIssueOpts{
+		Title: b.Title,
+		Body:  normalizeBody(b.Body),
+	})
+	if err != nil {
+		return err
+	}
+	if s.cfg.IsArchiveStatus(b.Status) {
+		state := issueState(s.cfg, b)
+		if _, err := s.provider.UpdateIssue(ctx, s.opts.RepoDir, issue.Number, state); err != nil {
+			return err
+		}
+	}
+
+	updated.Issue = issue.Number
+	if updated.Source == "" {
+		updated.Source = issue.URL
+	}
+	if err := s.write(b, updated); err != nil {
+		return err
+	}
+	s.result.Created = append(s.result.Created, updated)
+	return nil
+}
+
+// push updates an issue with the title, body and state of its bean.
+func (s *syncer) push(ctx context.Context, b *bean.Bean, issue *forge.Issue) error {
+	var opts forge.UpdateIssueOpts
+	if b.Title != issue.Title {
+		opts.Title = &b.Title
+	}
+	if body := normalizeBody(b.Body); body != normalizeBody(issue.Body) {
+		opts.Body = &body
+	}
+	if s.cfg.IsArchiveStatus(b.Status) != (issue.State == "closed") {
+		state := issueState(s.cfg, b)
+		opts.State, opts.StateReason = state.State, state.StateReason
+	}
+	if s.opts.DryRun {
+		return nil
+	}
+	_, err := s.provider.UpdateIssue(ctx, s.opts.RepoDir, issue.Number, opts)
+	return err
+}
+
+// write saves the changes sync made to a bean, unless this is a dry run.
+func (s *syncer) write(b, updated *bean.Bean) error {
+	if s.opts.DryRun {
+		return nil
+	}
+	// Projects that require an etag get the one the bean had when read
+	var ifMatch *string
+	if s.cfg.Beans.RequireIfMatch {
+		etag := b.ETag()
+		ifMatch = &etag
+	}
+	return s.core.Update(updated, ifMatch)
+}
+
+// differs reports whether a bean and its issue have a different title, body
+// or open/closed state.
+func (s *syncer) differs(b *bean.Bean, issue *forge.Issue) bool {
+	return b.Title != issue.Title ||
+		normalizeBody(b.Body) != normalizeBody(issue.Body) ||
+		s.cfg.IsArchiveStatus(b.Status) != (issue.State == "closed")
+}
+
+// issueState returns the issue state for a bean's status: closed for
+// archive statuses (as not planned if scrapped), open otherwise.
+func issueState(cfg *config.Config, b *bean.Bean) forge.UpdateIssueOpts {
+	switch {
+	case !cfg.IsArchiveStatus(b.Status):
+		return forge.UpdateIssueOpts{State: "open"}
+	case b.Status == "scrapped":
+		return forge.UpdateIssueOpts{State: "closed", StateReason: "not_planned"}
+	default:
+		return forge.UpdateIssueOpts{State: "closed", StateReason: "completed"}
+	}
+}
+
+// addComments adds the issue comments a bean doesn't have yet to its
+// thread. Comments are matched by author and creation time.
+func addComments(b *bean.Bean, comments []forge.IssueComment) {
+	have := make(map[string]bool, len(b.Comments))
+	for _, c := range b.Comments {
+		have[commentKey(c.Author, c.CreatedAt)] = true
+	}
+	for _, c := range comments {
+		if have[commentKey(c.Author, c.CreatedAt)] || strings.TrimSpace(c.Body) == "" {
+			continue
+		}
+		// AddComment only fails for empty text, which is skipped above
+		_ = b.AddComment(c.Author, normalizeBody(c.Body), c.CreatedAt)
+	}
+}
+
+// commentKey identifies a comment by author and creation time.
+func commentKey(author string, at time.Time) string {
+	return author + "\x00" + at.UTC().Format(time.RFC3339)
+}
+
+// IssueNumber returns the issue number of an issue URL such as
+// https://github.com/owner/repo/issues/12, or 0 if it is not one.
+func IssueNumber(url string) int {
+	i := strings.LastIndex(url, "/issues/")
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(url[i+len("/issues/"):])
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
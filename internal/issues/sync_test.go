@@ -0,0 +1,217 @@
+package issues
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/forge"
+)
+
+// fakeGH puts a fake gh on PATH that logs its arguments, one invocation per
+// line, and serves `gh issue view` from the given issue JSON by number.
+// `gh issue create` prints the URL of issue 7. Returns a function that
+// returns the logged invocations.
+func fakeGH(t *testing.T, issues map[int]string) func() []string {
+	t.Helper()
+	dir := t.TempDir()
+	for n, data := range issues {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("issue-%d.json", n)), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	script := `#!/bin/sh
+echo "$*" >> "` + dir + `/log"
+case "$1 $2" in
+"issue view") cat "` + dir + `/issue-${3##*/}.json" ;;
+"issue create") echo "https://github.com/acme/app/issues/7" ;;
+"issue edit"|"issue close"|"issue reopen") ;;
+*) echo "unexpected gh $*" >&2; exit 1 ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(dir, "gh"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	return func() []string {
+		data, err := os.ReadFile(filepath.Join(dir, "log"))
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+}
+
+// issueJSON returns the `gh issue view` JSON of issue 7.
+func issueJSON(title, state, stateReason, updatedAt string, comments string) string {
+	return fmt.Sprintf(`{
+		"number": 7, "title": %q, "body": "Steps", "state": %q, "stateReason": %q,
+		"url": "https://github.com/acme/app/issues/7",
+		"comments": [%s],
+		"createdAt": "2020-01-01T00:00:00Z", "updatedAt": %q
+	}`, title, state, stateReason, comments, updatedAt)
+}
+
+// reload returns a bean as stored on disk.
+func reload(t *testing.T, core *beancore.Core, id string) *bean.Bean {
+	t.Helper()
+	fresh := beancore.New(core.Root(), core.Config())
+	fresh.SetWarnWriter(nil)
+	if err := fresh.Load(); err != nil {
+		t.Fatalf("failed to reload core: %v", err)
+	}
+	b, err := fresh.Get(id)
+	if err != nil {
+		t.Fatalf("Get(%s) error = %v", id, err)
+	}
+	return b
+}
+
+func TestSyncCreatesIssues(t *testing.T) {
+	core := setupTestCore(t)
+	calls := fakeGH(t, map[int]string{7: issueJSON("Rewrite in Rust", "OPEN", "", "2020-01-01T00:00:00Z", "")})
+
+	b := &bean.Bean{ID: "sync-1", Slug: "rewrite-in-rust", Title: "Rewrite in Rust", Status: "scrapped", Body: "Steps"}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Sync(context.Background(), core, &forge.GitHub{}, []*bean.Bean{b}, SyncOptions{RepoDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(result.Created) != 1 {
+		t.Fatalf("Created = %d, want 1", len(result.Created))
+	}
+
+	synced := reload(t, core, "sync-1")
+	if synced.Issue != 7 || synced.Source != "https://github.com/acme/app/issues/7" {
+		t.Errorf("bean issue = %d, source = %q, want 7 and the issue URL", synced.Issue, synced.Source)
+	}
+	got := calls()
+	if len(got) < 3 || got[0] != "issue create --title Rewrite in Rust --body Steps" || !slices.Contains(got, "issue close 7 --reason not planned") {
+		t.Errorf("gh calls = %q, want create and close as not planned", got)
+	}
+}
+
+func TestSyncPullsNewerIssue(t *testing.T) {
+	core := setupTestCore(t)
+	comment := `{"author": {"login": "octocat"}, "body": "Fixed in main", "createdAt": "2099-01-01T00:00:00Z"}`
+	calls := fakeGH(t, map[int]string{7: issueJSON("Login broken on Safari", "CLOSED", "COMPLETED", "2099-01-01T00:00:00Z", comment)})
+
+	b := &bean.Bean{ID: "sync-1", Slug: "login-broken", Title: "Login broken", Status: "in-progress", Body: "Steps", Issue: 7}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Sync(context.Background(), core, &forge.GitHub{}, []*bean.Bean{b}, SyncOptions{RepoDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(result.Pulled) != 1 {
+		t.Fatalf("Pulled = %d, want 1", len(result.Pulled))
+	}
+
+	synced := reload(t, core, "sync-1")
+	if synced.Title != "Login broken on Safari" || synced.Status != "completed" {
+		t.Errorf("bean title = %q, status = %q, want the issue's", synced.Title, synced.Status)
+	}
+	if len(synced.Comments) != 1 || synced.Comments[0].Author != "octocat" || synced.Comments[0].Text != "Fixed in main" {
+		t.Errorf("Comments = %+v, want the issue comment", synced.Comments)
+	}
+	if got := calls(); slices.ContainsFunc(got, func(c string) bool { return !strings.HasPrefix(c, "issue view") }) {
+		t.Errorf("gh calls = %q, want only views when pulling", got)
+	}
+
+	// Syncing again finds nothing to do, and doesn't add the comment twice
+	result, err = Sync(context.Background(), core, &forge.GitHub{}, []*bean.Bean{synced}, SyncOptions{RepoDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("second Sync() error = %v", err)
+	}
+	if len(result.Unchanged) != 1 {
+		t.Errorf("second sync: Unchanged = %d, want 1 (pulled %d)", len(result.Unchanged), len(result.Pulled))
+	}
+}
+
+func TestSyncPushesNewerBean(t *testing.T) {
+	core := setupTestCore(t)
+	calls := fakeGH(t, map[int]string{7: issueJSON("Login broken", "OPEN", "", "2020-01-01T00:00:00Z", "")})
+
+	// Imported beans are linked to their issue by source
+	b := &bean.Bean{ID: "sync-1", Slug: "login-broken-on-safari", Title: "Login broken on Safari", Status: "completed", Body: "Steps", Source: "https://github.com/acme/app/issues/7"}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Sync(context.Background(), core, &forge.GitHub{}, []*bean.Bean{b}, SyncOptions{RepoDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(result.Pushed) != 1 {
+		t.Fatalf("Pushed = %d, want 1", len(result.Pushed))
+	}
+
+	got := calls()
+	for _, want := range []string{"issue edit 7 --title Login broken on Safari", "issue close 7 --reason completed"} {
+		if !slices.Contains(got, want) {
+			t.Errorf("gh calls = %q, want %q", got, want)
+		}
+	}
+	if synced := reload(t, core, "sync-1"); synced.Title != "Login broken on Safari" || synced.Issue != 7 {
+		t.Errorf("bean title = %q, issue = %d, want the bean's title kept and issue 7 stored", synced.Title, synced.Issue)
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	core := setupTestCore(t)
+	calls := fakeGH(t, map[int]string{7: issueJSON("Login broken", "OPEN", "", "2099-01-01T00:00:00Z", "")})
+
+	unlinked := &bean.Bean{ID: "sync-1", Slug: "new-idea", Title: "New idea", Status: "todo"}
+	linked := &bean.Bean{ID: "sync-2", Slug: "old-title", Title: "Old title", Status: "todo", Body: "Steps", Issue: 7}
+	for _, b := range []*bean.Bean{unlinked, linked} {
+		if err := core.Create(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Sync(context.Background(), core, &forge.GitHub{}, []*bean.Bean{unlinked, linked}, SyncOptions{RepoDir: t.TempDir(), DryRun: true})
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(result.Created) != 1 || len(result.Pulled) != 1 {
+		t.Errorf("Created = %d, Pulled = %d, want 1 each", len(result.Created), len(result.Pulled))
+	}
+	if got := calls(); !slices.Equal(got, []string{"issue view 7 --json " + forge.GitHubIssueFields + ",comments"}) {
+		t.Errorf("gh calls = %q, want only the view", got)
+	}
+	if b := reload(t, core, "sync-2"); b.Title != "Old title" {
+		t.Errorf("dry run changed the bean title to %q", b.Title)
+	}
+}
+
+func TestIssueNumber(t *testing.T) {
+	tests := []struct {
+		url  string
+		want int
+	}{
+		{"https://github.com/acme/app/issues/12", 12},
+		{"https://gitlab.com/acme/app/-/issues/3", 3},
+		{"https://github.com/acme/app/milestone/1", 0},
+		{"https://github.com/acme/app/issues/new", 0},
+		{"", 0},
+	}
+	for _, tc := range tests {
+		if got := IssueNumber(tc.url); got != tc.want {
+			t.Errorf("IssueNumber(%q) = %d, want %d", tc.url, got, tc.want)
+		}
+	}
+}
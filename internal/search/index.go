@@ -2,22 +2,77 @@
 package search
 
 import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/registry"
+	"github.com/blevesearch/bleve/v2/search/highlight"
+	"github.com/blevesearch/bleve/v2/search/highlight/format/plain"
+	simpleFragmenter "github.com/blevesearch/bleve/v2/search/highlight/fragmenter/simple"
+	simpleHighlighter "github.com/blevesearch/bleve/v2/search/highlight/highlighter/simple"
 	"github.com/hmans/beans/pkg/bean"
 )
 
-// Index wraps a Bleve in-memory index for searching beans.
+// Index wraps a Bleve index for searching beans. It is either held in memory
+// or persisted on disk (see OpenIndex).
 type Index struct {
 	index bleve.Index
+
+	// beansRoot is the directory bean paths are relative to, used to stat
+	// bean files for their stamps. Only set for persistent indexes.
+	beansRoot string
+
+	mu     sync.Mutex
+	stamps map[string]Stamp // bean ID -> stamp of the indexed version
+	dirty  bool             // stamps changed since they were last stored
+}
+
+// Stamp identifies the version of a bean that is in the index: the path and
+// modification time of its file and a hash of its indexed content.
+type Stamp struct {
+	Path    string `json:"path"`
+	ModTime int64  `json:"mtime"`
+	Hash    string `json:"hash"`
 }
 
 // beanDocument is the structure stored in the Bleve index.
 type beanDocument struct {
-	ID    string `json:"id"`
-	Slug  string `json:"slug"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	ID     string   `json:"id"`
+	Slug   string   `json:"slug"`
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Tags   []string `json:"tags"`
+	Type   string   `json:"type"`
+	Status string   `json:"status"`
+	// Archived is "true" or "false". It is a keyword rather than a boolean
+	// so that archived:true works in query strings.
+	Archived string `json:"archived"`
+}
+
+// highlightStyle is the name of the highlighter used for search hits. It
+// marks matched terms as Markdown bold.
+const highlightStyle = "beans-markdown"
+
+func init() {
+	err := registry.RegisterHighlighter(highlightStyle, func(config map[string]any, cache *registry.Cache) (highlight.Highlighter, error) {
+		fragmenter, err := cache.FragmenterNamed(simpleFragmenter.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error building fragmenter: %w", err)
+		}
+		formatter := plain.NewFragmentFormatter("**", "**")
+		return simpleHighlighter.NewHighlighter(fragmenter, formatter, simpleHighlighter.DefaultSeparator), nil
+	})
+	if err != nil {
+		panic(err)
+	}
 }
 
 // NewIndex creates a new in-memory Bleve index.
@@ -28,7 +83,48 @@ func NewIndex() (*Index, error) {
 		return nil, err
 	}
 
-	return &Index{index: idx}, nil
+	return &Index{index: idx, stamps: make(map[string]Stamp)}, nil
+}
+
+// newDocument returns the document indexed for a bean. Beans in the archive
+// directory are marked as archived.
+func newDocument(b *bean.Bean) beanDocument {
+	return beanDocument{
+		ID:       b.ID,
+		Slug:     b.Slug,
+		Title:    b.Title,
+		Body:     b.Body,
+		Tags:     b.Tags,
+		Type:     b.Type,
+		Status:   b.Status,
+		Archived: strconv.FormatBool(strings.HasPrefix(filepath.ToSlash(b.Path), "archive/")),
+	}
+}
+
+// hashDocument returns a hash of a document's content.
+func hashDocument(doc beanDocument) string {
+	data, _ := json.Marshal(doc)
+	h := fnv.New64a()
+	h.Write(data)
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// stamp returns the stamp of a bean indexed as doc.
+func (idx *Index) stamp(b *bean.Bean, doc beanDocument) Stamp {
+	return Stamp{Path: b.Path, ModTime: idx.modTime(b), Hash: hashDocument(doc)}
+}
+
+// modTime returns the modification time of a bean's file in nanoseconds, or 0
+// if the index is in memory or the file can't be stat'ed.
+func (idx *Index) modTime(b *bean.Bean) int64 {
+	if idx.beansRoot == "" || b.Path == "" {
+		return 0
+	}
+	info, err := os.Stat(filepath.Join(idx.beansRoot, b.Path))
+	if err != nil {
+		return 0
+	}
+	return info.ModTime().UnixNano()
 }
 
 // buildIndexMapping creates the Bleve index mapping for bean documents.
@@ -37,15 +133,25 @@ func buildIndexMapping() mapping.IndexMapping {
 	textFieldMapping := bleve.NewTextFieldMapping()
 	textFieldMapping.Analyzer = "standard"
 
-	// Create a keyword field mapping for ID (stored but not analyzed)
+	// Create a keyword field mapping for ID and tags (stored but not analyzed)
 	keywordFieldMapping := bleve.NewKeywordFieldMapping()
 
+	// Type, status and archived are only searched by field (e.g. type:bug),
+	// so that a term like "bug" or "todo" doesn't match every bean of that
+	// type or status
+	fieldOnlyMapping := bleve.NewKeywordFieldMapping()
+	fieldOnlyMapping.IncludeInAll = false
+
 	// Create the document mapping
 	beanMapping := bleve.NewDocumentMapping()
 	beanMapping.AddFieldMappingsAt("id", keywordFieldMapping)
 	beanMapping.AddFieldMappingsAt("slug", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("title", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("body", textFieldMapping)
+	beanMapping.AddFieldMappingsAt("tags", keywordFieldMapping)
+	beanMapping.AddFieldMappingsAt("type", fieldOnlyMapping)
+	beanMapping.AddFieldMappingsAt("status", fieldOnlyMapping)
+	beanMapping.AddFieldMappingsAt("archived", fieldOnlyMapping)
 
 	// Create the index mapping with BM25 scoring for better relevance ranking
 	indexMapping := bleve.NewIndexMapping()
@@ -63,25 +169,90 @@ func buildIndexMapping() mapping.IndexMapping {
 	return indexMapping
 }
 
-// Close closes the index.
+// Close closes the index. A persistent index stores its stamps first.
 func (idx *Index) Close() error {
-	return idx.index.Close()
+	idx.mu.Lock()
+	var err error
+	if idx.dirty && idx.beansRoot != "" {
+		err = idx.storeStamps()
+	}
+	idx.mu.Unlock()
+
+	if closeErr := idx.index.Close(); closeErr != nil {
+		return closeErr
+	}
+	return err
 }
 
 // IndexBean adds or updates a bean in the search index.
 func (idx *Index) IndexBean(b *bean.Bean) error {
-	doc := beanDocument{
-		ID:    b.ID,
-		Slug:  b.Slug,
-		Title: b.Title,
-		Body:  b.Body,
+	doc := newDocument(b)
+	if err := idx.index.Index(b.ID, doc); err != nil {
+		return err
 	}
-	return idx.index.Index(b.ID, doc)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.stamps[b.ID] = idx.stamp(b, doc)
+	idx.dirty = true
+	return nil
 }
 
 // DeleteBean removes a bean from the search index.
 func (idx *Index) DeleteBean(id string) error {
-	return idx.index.Delete(id)
+	if err := idx.index.Delete(id); err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	delete(idx.stamps, id)
+	idx.dirty = true
+	return nil
+}
+
+// Sync brings the index up to date with beans, the complete set of beans.
+// Beans that changed since they were indexed are (re)indexed and beans that
+// are no longer in the set are removed. A bean is unchanged if its file's
+// path and modification time match its stamp or, failing that, if the hash
+// of its indexed content does. Returns the number of beans (re)indexed or removed.
+func (idx *Index) Sync(beans []*bean.Bean) (int, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	batch := idx.index.NewBatch()
+	stamps := make(map[string]Stamp, len(beans))
+	changed := 0
+	for _, b := range beans {
+		old, indexed := idx.stamps[b.ID]
+		if indexed && old.ModTime != 0 && old.Path == b.Path && old.ModTime == idx.modTime(b) {
+			stamps[b.ID] = old
+			continue
+		}
+
+		doc := newDocument(b)
+		stamps[b.ID] = idx.stamp(b, doc)
+		if indexed && old.Hash == stamps[b.ID].Hash {
+			continue
+		}
+		if err := batch.Index(b.ID, doc); err != nil {
+			return 0, err
+		}
+		changed++
+	}
+	for id := range idx.stamps {
+		if _, ok := stamps[id]; !ok {
+			batch.Delete(id)
+			changed++
+		}
+	}
+
+	if err := idx.index.Batch(batch); err != nil {
+		return 0, err
+	}
+	idx.stamps = stamps
+	idx.dirty = true
+	return changed, nil
 }
 
 // DefaultSearchLimit is the default maximum number of search results.
@@ -90,20 +261,7 @@ const DefaultSearchLimit = 1000
 // Search executes a search query and returns matching bean IDs.
 // The limit parameter controls the maximum number of results (0 uses DefaultSearchLimit).
 func (idx *Index) Search(queryStr string, limit int) ([]string, error) {
-	if limit <= 0 {
-		limit = DefaultSearchLimit
-	}
-
-	// Use query string syntax which supports:
-	// - Simple terms: "authentication"
-	// - Boolean operators: "user AND password"
-	// - Wildcards: "auth*"
-	// - Phrases: "\"user login\""
-	// - Field-specific: "title:login"
-	query := bleve.NewQueryStringQuery(queryStr)
-
-	searchRequest := bleve.NewSearchRequest(query)
-	searchRequest.Size = limit
+	searchRequest := newSearchRequest(queryStr, limit)
 	searchRequest.Fields = []string{"id"} // Only return ID field
 
 	result, err := idx.index.Search(searchRequest)
@@ -119,19 +277,74 @@ func (idx *Index) Search(queryStr string, limit int) ([]string, error) {
 	return ids, nil
 }
 
+// Hit is a bean matching a search, with its relevance score and the
+// matching fragments of each field that matched. Matched terms are wrapped
+// in ** (Markdown bold).
+type Hit struct {
+	ID         string
+	Score      float64
+	Highlights map[string][]string
+}
+
+// SearchHits executes a search query like Search, but returns the hits with
+// their scores and highlighted fragments, best match first.
+func (idx *Index) SearchHits(queryStr string, limit int) ([]Hit, error) {
+	searchRequest := newSearchRequest(queryStr, limit)
+	searchRequest.Highlight = bleve.NewHighlightWithStyle(highlightStyle)
+
+	result, err := idx.index.Search(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]Hit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		hits = append(hits, Hit{ID: hit.ID, Score: hit.Score, Highlights: hit.Fragments})
+	}
+
+	return hits, nil
+}
+
+// newSearchRequest builds a request for a query string, returning at most
+// limit hits (0 uses DefaultSearchLimit).
+func newSearchRequest(queryStr string, limit int) *bleve.SearchRequest {
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	// Use query string syntax which supports:
+	// - Simple terms: "authentication"
+	// - Boolean operators: "user AND password"
+	// - Wildcards: "auth*"
+	// - Phrases: "\"user login\""
+	// - Field-specific: "title:login", "tags:auth", "type:bug", "archived:true"
+	query := bleve.NewQueryStringQuery(queryStr)
+
+	searchRequest := bleve.NewSearchRequest(query)
+	searchRequest.Size = limit
+	return searchRequest
+}
+
 // IndexBeans indexes multiple beans in a batch for efficiency.
 func (idx *Index) IndexBeans(beans []*bean.Bean) error {
 	batch := idx.index.NewBatch()
+	stamps := make(map[string]Stamp, len(beans))
 	for _, b := range beans {
-		doc := beanDocument{
-			ID:    b.ID,
-			Slug:  b.Slug,
-			Title: b.Title,
-			Body:  b.Body,
-		}
+		doc := newDocument(b)
 		if err := batch.Index(b.ID, doc); err != nil {
 			return err
 		}
+		stamps[b.ID] = idx.stamp(b, doc)
+	}
+	if err := idx.index.Batch(batch); err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for id, stamp := range stamps {
+		idx.stamps[id] = stamp
 	}
-	return idx.index.Batch(batch)
+	idx.dirty = true
+	return nil
 }
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
@@ -399,3 +400,65 @@ func TestSearch_DefaultLimit(t *testing.T) {
 		t.Errorf("Search with limit 0 (default) returned %d results, want 1", len(ids))
 	}
 }
+
+func TestSearch_TagsTypeStatusArchived(t *testing.T) {
+	idx := setupTestIndex(t)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Path: "aaa1.md", Title: "Login form", Tags: []string{"auth"}, Type: "bug", Status: "todo"},
+		{ID: "bbb2", Path: "archive/bbb2.md", Title: "Old login form", Type: "feature", Status: "completed"},
+		{ID: "ccc3", Path: "ccc3.md", Title: "Bug report template", Type: "task", Status: "todo"},
+	}
+	if err := idx.IndexBeans(beans); err != nil {
+		t.Fatalf("IndexBeans() error = %v", err)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"auth", []string{"aaa1"}},
+		{"tags:auth", []string{"aaa1"}},
+		{"type:bug", []string{"aaa1"}},
+		{"bug", []string{"ccc3"}}, // type is only searched by field
+		{"status:completed", []string{"bbb2"}},
+		{"archived:true", []string{"bbb2"}},
+		{"+login +archived:false", []string{"aaa1"}},
+	}
+	for _, tt := range tests {
+		ids, err := idx.Search(tt.query, 10)
+		if err != nil {
+			t.Fatalf("Search(%q) error = %v", tt.query, err)
+		}
+		if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, ids, tt.want)
+		}
+	}
+}
+
+func TestSearchHits(t *testing.T) {
+	idx := setupTestIndex(t)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Session handling", Body: "Refresh the authentication token on expiry."},
+		{ID: "bbb2", Title: "Authentication", Body: "Authentication via OAuth."},
+	}
+	if err := idx.IndexBeans(beans); err != nil {
+		t.Fatalf("IndexBeans() error = %v", err)
+	}
+
+	hits, err := idx.SearchHits("authentication", 10)
+	if err != nil {
+		t.Fatalf("SearchHits() error = %v", err)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("SearchHits() returned %d hits, want 2", len(hits))
+	}
+	if hits[0].ID != "bbb2" || hits[0].Score <= hits[1].Score {
+		t.Errorf("SearchHits() = %+v, want bbb2 ranked first", hits)
+	}
+	body := hits[1].Highlights["body"]
+	if len(body) != 1 || body[0] != "Refresh the **authentication** token on expiry." {
+		t.Errorf("body highlights = %q, want the matched term in bold", body)
+	}
+}
@@ -0,0 +1,111 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/index/scorch"
+	bolt "go.etcd.io/bbolt"
+)
+
+// indexVersion identifies the document mapping. Bump it whenever
+// beanDocument or buildIndexMapping changes, so that persistent indexes built
+// with the old mapping are rebuilt.
+const indexVersion = "2"
+
+// Internal keys stored alongside the documents of a persistent index.
+const (
+	versionKey = "beans:version"
+	stampsKey  = "beans:stamps"
+)
+
+// ErrIndexLocked is returned by OpenIndex when another process has the
+// persistent index open.
+var ErrIndexLocked = errors.New("search index is in use by another process")
+
+// indexConfig is the Bleve configuration for persistent indexes: opening
+// gives up quickly if another process holds the index.
+var indexConfig = map[string]any{
+	"bolt_timeout": "500ms",
+}
+
+// OpenIndex opens the persistent index in dir, creating it if it doesn't
+// exist yet. beansRoot is the directory bean paths are relative to. An index
+// that is stale (built with a different mapping) or corrupt is removed and
+// created again, empty; use Sync to bring it up to date.
+func OpenIndex(dir, beansRoot string) (*Index, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return createIndex(dir, beansRoot)
+	}
+
+	idx, err := openIndex(dir, beansRoot)
+	if err == nil || errors.Is(err, ErrIndexLocked) {
+		return idx, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("removing stale search index: %w", err)
+	}
+	return createIndex(dir, beansRoot)
+}
+
+// createIndex creates a new, empty persistent index in dir.
+func createIndex(dir, beansRoot string) (*Index, error) {
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return nil, err
+	}
+	bi, err := bleve.NewUsing(dir, buildIndexMapping(), scorch.Name, scorch.Name, indexConfig)
+	if err != nil {
+		return nil, fmt.Errorf("creating search index: %w", err)
+	}
+	if err := bi.SetInternal([]byte(versionKey), []byte(indexVersion)); err != nil {
+		bi.Close()
+		return nil, fmt.Errorf("creating search index: %w", err)
+	}
+	return &Index{index: bi, beansRoot: beansRoot, stamps: make(map[string]Stamp)}, nil
+}
+
+// openIndex opens an existing persistent index and loads its stamps.
+func openIndex(dir, beansRoot string) (*Index, error) {
+	bi, err := bleve.OpenUsing(dir, indexConfig)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, ErrIndexLocked
+		}
+		return nil, fmt.Errorf("opening search index: %w", err)
+	}
+
+	version, err := bi.GetInternal([]byte(versionKey))
+	if err != nil || string(version) != indexVersion {
+		bi.Close()
+		return nil, fmt.Errorf("search index is stale (version %q, want %q)", version, indexVersion)
+	}
+
+	stamps := make(map[string]Stamp)
+	data, err := bi.GetInternal([]byte(stampsKey))
+	if err == nil && data != nil {
+		err = json.Unmarshal(data, &stamps)
+	}
+	if err != nil {
+		bi.Close()
+		return nil, fmt.Errorf("reading search index stamps: %w", err)
+	}
+
+	return &Index{index: bi, beansRoot: beansRoot, stamps: stamps}, nil
+}
+
+// storeStamps writes the stamps into the index. Must be called with mu held.
+func (idx *Index) storeStamps() error {
+	data, err := json.Marshal(idx.stamps)
+	if err != nil {
+		return err
+	}
+	if err := idx.index.SetInternal([]byte(stampsKey), data); err != nil {
+		return err
+	}
+	idx.dirty = false
+	return nil
+}
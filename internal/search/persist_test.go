@@ -0,0 +1,183 @@
+package search
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// writeBeanFile writes a bean file so the index can stat it for its stamp.
+func writeBeanFile(t *testing.T, root string, b *bean.Bean, mtime time.Time) {
+	t.Helper()
+	path := filepath.Join(root, b.Path)
+	if err := os.WriteFile(path, []byte(b.Title), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpenIndex_Incremental(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".cache", "search")
+	mtime := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Path: "aaa1.md", Title: "Authentication"},
+		{ID: "bbb2", Path: "bbb2.md", Title: "Database"},
+		{ID: "ccc3", Path: "ccc3.md", Title: "Deployment"},
+	}
+	for _, b := range beans {
+		writeBeanFile(t, root, b, mtime)
+	}
+
+	idx, err := OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() error = %v", err)
+	}
+	if n, err := idx.Sync(beans); err != nil || n != 3 {
+		t.Fatalf("first Sync() = %d, %v; want 3 changes", n, err)
+	}
+	if err := idx.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Change one bean's content, touch another without changing its
+	// content, and drop the third.
+	beans[0].Title = "Authorization"
+	writeBeanFile(t, root, beans[0], mtime.Add(time.Minute))
+	writeBeanFile(t, root, beans[1], mtime.Add(time.Minute))
+	beans = beans[:2]
+
+	idx, err = OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("reopening: OpenIndex() error = %v", err)
+	}
+	defer idx.Close()
+	n, err := idx.Sync(beans)
+	if err != nil {
+		t.Fatalf("second Sync() error = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("second Sync() = %d changes, want 2 (one updated, one removed)", n)
+	}
+
+	for query, want := range map[string]int{"Authorization": 1, "Authentication": 0, "Database": 1, "Deployment": 0} {
+		ids, err := idx.Search(query, 10)
+		if err != nil {
+			t.Fatalf("Search(%s) error = %v", query, err)
+		}
+		if len(ids) != want {
+			t.Errorf("Search(%s) = %v, want %d results", query, ids, want)
+		}
+	}
+
+	if n, err := idx.Sync(beans); err != nil || n != 0 {
+		t.Errorf("third Sync() = %d, %v; want no changes", n, err)
+	}
+}
+
+func TestOpenIndex_KeepsIndexBeanUpdates(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".cache", "search")
+	b := &bean.Bean{ID: "aaa1", Path: "aaa1.md", Title: "Authentication"}
+	writeBeanFile(t, root, b, time.Now())
+
+	idx, err := OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() error = %v", err)
+	}
+	if err := idx.IndexBean(b); err != nil {
+		t.Fatalf("IndexBean() error = %v", err)
+	}
+	if err := idx.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	idx, err = OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("reopening: OpenIndex() error = %v", err)
+	}
+	defer idx.Close()
+	if n, err := idx.Sync([]*bean.Bean{b}); err != nil || n != 0 {
+		t.Errorf("Sync() = %d, %v; want no changes for a bean indexed before closing", n, err)
+	}
+}
+
+func TestOpenIndex_RebuildsStaleIndex(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".cache", "search")
+	b := &bean.Bean{ID: "aaa1", Path: "aaa1.md", Title: "Authentication"}
+	writeBeanFile(t, root, b, time.Now())
+
+	idx, err := OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() error = %v", err)
+	}
+	if _, err := idx.Sync([]*bean.Bean{b}); err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.index.SetInternal([]byte(versionKey), []byte("0")); err != nil {
+		t.Fatal(err)
+	}
+	idx.Close()
+
+	idx, err = OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() on a stale index error = %v", err)
+	}
+	defer idx.Close()
+	if n, err := idx.Sync([]*bean.Bean{b}); err != nil || n != 1 {
+		t.Errorf("Sync() = %d, %v; want the bean indexed again", n, err)
+	}
+}
+
+func TestOpenIndex_RebuildsCorruptIndex(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".cache", "search")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index_meta.json"), []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	idx, err := OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() on a corrupt index error = %v", err)
+	}
+	defer idx.Close()
+	b := &bean.Bean{ID: "aaa1", Title: "Authentication"}
+	if err := idx.IndexBean(b); err != nil {
+		t.Fatalf("IndexBean() error = %v", err)
+	}
+	if ids, _ := idx.Search("Authentication", 10); len(ids) != 1 {
+		t.Errorf("Search() = %v, want [aaa1]", ids)
+	}
+}
+
+func TestOpenIndex_Locked(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".cache", "search")
+
+	idx, err := OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() error = %v", err)
+	}
+	idx.Close()
+
+	idx, err = OpenIndex(dir, root)
+	if err != nil {
+		t.Fatalf("OpenIndex() error = %v", err)
+	}
+	defer idx.Close()
+
+	if _, err := OpenIndex(dir, root); !errors.Is(err, ErrIndexLocked) {
+		t.Errorf("OpenIndex() while open elsewhere error = %v, want ErrIndexLocked", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -119,6 +120,7 @@ func (d linkDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 			TagsColWidth:  d.cols.Tags,
 			MaxTags:       d.cols.MaxTags,
 			UseFullNames:  true, // Full type/status names in detail view
+			Assignees:     link.bean.Assignees,
 		},
 	)
 
@@ -384,6 +386,12 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 			return m, func() tea.Msg {
 				return copyBeanIDMsg{ids: []string{m.bean.ID}}
 			}
+
+		case "u", "U":
+			// Undo the last change, or redo with shift
+			return m, func() tea.Msg {
+				return undoMsg{redo: msg.String() == "U"}
+			}
 		}
 	}
 
@@ -517,6 +525,23 @@ func (m detailModel) renderHeader() string {
 		headerContent.WriteString(ui.RenderTags(m.bean.Tags))
 	}
 
+	// Add assignees if present
+	if len(m.bean.Assignees) > 0 {
+		headerContent.WriteString("  ")
+		headerContent.WriteString(ui.RenderAssignees(m.bean.Assignees))
+	}
+
+	// Add due date if present, highlighted when overdue
+	if m.bean.DueAt != nil {
+		headerContent.WriteString("  ")
+		due := "due " + bean.FormatDate(*m.bean.DueAt)
+		if m.resolver.Core.IsOverdue(m.bean, time.Now()) {
+			headerContent.WriteString(ui.Danger.Render(due))
+		} else {
+			headerContent.WriteString(ui.Muted.Render(due))
+		}
+	}
+
 	// Header box style - always muted border (not focused, links section is separate)
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
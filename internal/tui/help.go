@@ -87,6 +87,9 @@ func (m helpOverlayModel) View() string {
 	content.WriteString(shortcut("P", "Change priority") + "\n")
 	content.WriteString(shortcut("s", "Change status") + "\n")
 	content.WriteString(shortcut("t", "Change type") + "\n")
+	content.WriteString(shortcut("u", "Undo last change") + "\n")
+	content.WriteString(shortcut("U", "Redo undone change") + "\n")
+	content.WriteString(shortcut("v", "Switch to next view") + "\n")
 	content.WriteString(shortcut("y", "Copy bean ID") + "\n")
 	content.WriteString(shortcut("/", "Filter") + "\n")
 	content.WriteString(shortcut("g t", "Filter by tag") + "\n")
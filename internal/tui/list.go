@@ -96,6 +96,7 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 			IDColWidth:      d.idColWidth,
 			UseFullNames:    d.cols.UseFullTypeStatus,
 			ImplicitStatus: item.implicitStatus,
+			Assignees:      item.bean.Assignees,
 		},
 	)
 
@@ -117,7 +118,8 @@ type listModel struct {
 	idColWidth int                  // ID column width (accounts for tree depth)
 
 	// Active filters
-	tagFilter string // if set, only show beans with this tag
+	tagFilter string             // if set, only show beans with this tag
+	view      *config.ViewConfig // if set, show the beans of this named view
 
 	// Multi-select state
 	selectedBeans map[string]bool // IDs of beans marked for multi-edit
@@ -169,10 +171,20 @@ func (m listModel) Init() tea.Cmd {
 }
 
 func (m listModel) loadBeans() tea.Msg {
-	// Build filter if tag filter is set
+	// Build filter from the active view and tag filter
 	var filter *model.BeanFilter
+	if m.view != nil {
+		viewFilter, err := m.resolver.ViewFilter(m.view)
+		if err != nil {
+			return errMsg{err}
+		}
+		filter = viewFilter
+	}
 	if m.tagFilter != "" {
-		filter = &model.BeanFilter{Tags: []string{m.tagFilter}}
+		if filter == nil {
+			filter = &model.BeanFilter{}
+		}
+		filter.Tags = []string{m.tagFilter}
 	}
 
 	// Query filtered beans
@@ -189,6 +201,10 @@ func (m listModel) loadBeans() tea.Msg {
 
 	// Sort function for tree building
 	sortFn := func(beans []*bean.Bean) {
+		if m.view != nil {
+			beangraph.SortBeans(beans, m.view.Sort, m.config)
+			return
+		}
 		bean.SortByStatusPriorityAndType(beans, m.config.StatusNames(), m.config.PriorityNames(), m.config.TypeNames())
 	}
 
@@ -226,14 +242,35 @@ func (m *listModel) setTagFilter(tag string) {
 	m.tagFilter = tag
 }
 
+// nextView switches to the next named view from the config, or back to all
+// beans after the last one.
+func (m *listModel) nextView() {
+	views := m.config.Views
+	switch {
+	case len(views) == 0:
+		m.view = nil
+	case m.view == nil:
+		m.view = &views[0]
+	default:
+		current := m.view.Name
+		m.view = nil
+		for i := range views[:len(views)-1] {
+			if views[i].Name == current {
+				m.view = &views[i+1]
+			}
+		}
+	}
+}
+
 // clearFilter clears all active filters
 func (m *listModel) clearFilter() {
 	m.tagFilter = ""
+	m.view = nil
 }
 
 // hasActiveFilter returns true if any filter is active
 func (m *listModel) hasActiveFilter() bool {
-	return m.tagFilter != ""
+	return m.tagFilter != "" || m.view != nil
 }
 
 func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
@@ -445,6 +482,16 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 						return copyBeanIDMsg{ids: []string{item.bean.ID}}
 					}
 				}
+			case "u", "U":
+				// Undo the last change, or redo with shift
+				return m, func() tea.Msg {
+					return undoMsg{redo: msg.String() == "U"}
+				}
+			case "v":
+				// Switch to the next named view
+				return m, func() tea.Msg {
+					return nextViewMsg{}
+				}
 			case "esc", "backspace":
 				// First clear selection if any beans are selected
 				if len(m.selectedBeans) > 0 {
@@ -501,11 +548,13 @@ func (m listModel) View() string {
 		return "Loading..."
 	}
 
-	// Update title based on active filter
+	// Update title based on active filters
+	m.list.Title = "Beans"
+	if m.view != nil {
+		m.list.Title += fmt.Sprintf(" [view: %s]", m.view.Name)
+	}
 	if m.tagFilter != "" {
-		m.list.Title = fmt.Sprintf("Beans [tag: %s]", m.tagFilter)
-	} else {
-		m.list.Title = "Beans"
+		m.list.Title += fmt.Sprintf(" [tag: %s]", m.tagFilter)
 	}
 
 	// Inner height: total height minus border (2) minus footer (1) minus padding (1)
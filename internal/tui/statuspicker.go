@@ -83,8 +83,15 @@ type statusPickerModel struct {
 }
 
 func newStatusPickerModel(beanIDs []string, beanTitle, currentStatus string, cfg *config.Config, width, height int) statusPickerModel {
-	// Get all statuses (hardcoded in config package)
-	statuses := config.DefaultStatuses
+	// Offer only statuses reachable from the current one. For multi-bean edits
+	// there is no single current status, so all statuses are listed and
+	// illegal transitions are rejected when applied.
+	var statuses []config.StatusConfig
+	for _, s := range cfg.Statuses() {
+		if s.Name == currentStatus || cfg.CanTransition(currentStatus, s.Name) {
+			statuses = append(statuses, s)
+		}
+	}
 
 	delegate := statusItemDelegate{}
 
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+)
+
+func pickerStatusNames(m statusPickerModel) []string {
+	var names []string
+	for _, item := range m.list.Items() {
+		names = append(names, item.(statusItem).name)
+	}
+	return names
+}
+
+func TestStatusPickerTransitions(t *testing.T) {
+	cfg := config.Default()
+	cfg.CustomStatuses = []config.CustomStatusConfig{{Name: "in-review", Color: "magenta"}}
+	cfg.Transitions = map[string][]string{
+		"todo":        {"in-progress", "scrapped"},
+		"in-progress": {"in-review"},
+	}
+
+	t.Run("single bean shows only reachable statuses", func(t *testing.T) {
+		m := newStatusPickerModel([]string{"a"}, "A", "todo", cfg, 80, 40)
+		got := strings.Join(pickerStatusNames(m), ",")
+		if want := "in-progress,todo,scrapped"; got != want {
+			t.Errorf("statuses = %s, want %s", got, want)
+		}
+		if item := m.list.SelectedItem().(statusItem); item.name != "todo" {
+			t.Errorf("selected = %q, want current status %q", item.name, "todo")
+		}
+	})
+
+	t.Run("multi bean edit shows all statuses", func(t *testing.T) {
+		m := newStatusPickerModel([]string{"a", "b"}, "2 selected beans", "", cfg, 80, 40)
+		got := strings.Join(pickerStatusNames(m), ",")
+		if want := strings.Join(cfg.StatusNames(), ","); got != want {
+			t.Errorf("statuses = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("no transitions shows all statuses", func(t *testing.T) {
+		m := newStatusPickerModel([]string{"a"}, "A", "completed", config.Default(), 80, 40)
+		if got := len(pickerStatusNames(m)); got != len(config.DefaultStatuses) {
+			t.Errorf("len(statuses) = %d, want %d", got, len(config.DefaultStatuses))
+		}
+	})
+}
+
+func TestUpdateFailureMessage(t *testing.T) {
+	t.Run("single failure", func(t *testing.T) {
+		got := updateFailureMessage([]string{"a"}, []error{errors.New("boom")})
+		if want := "Failed to update a: boom"; got != want {
+			t.Errorf("updateFailureMessage() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("multiple failures name every bean and distinct error", func(t *testing.T) {
+		got := updateFailureMessage(
+			[]string{"a", "b", "c"},
+			[]error{errors.New("not allowed"), errors.New("not allowed"), errors.New("not found")},
+		)
+		if want := "Failed to update 3 beans (a, b, c): not allowed; not found"; got != want {
+			t.Errorf("updateFailureMessage() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestStatusSelectedReportsIllegalTransitions(t *testing.T) {
+	cfg := config.Default()
+	cfg.Transitions = map[string][]string{"todo": {"in-progress"}}
+
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("MkdirAll error = %v", err)
+	}
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, b := range []*bean.Bean{
+		{ID: "todo-1", Title: "Todo", Status: "todo"},
+		{ID: "draft-1", Title: "Draft", Status: "draft"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	app := New(core, cfg)
+	app.Update(statusSelectedMsg{beanIDs: []string{"todo-1", "draft-1"}, status: "completed"})
+
+	if !strings.Contains(app.list.statusMessage, "Failed to update todo-1") {
+		t.Errorf("statusMessage = %q, want failure for todo-1", app.list.statusMessage)
+	}
+	if b, _ := core.Get("todo-1"); b.Status != "todo" {
+		t.Errorf("todo-1 status = %q, want unchanged", b.Status)
+	}
+	if b, _ := core.Get("draft-1"); b.Status != "completed" {
+		t.Errorf("draft-1 status = %q, want %q", b.Status, "completed")
+	}
+}
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -86,6 +87,15 @@ type editorFinishedMsg struct {
 	err error
 }
 
+// undoMsg requests reverting the last journaled operation, or re-applying
+// the last undone one if redo is set
+type undoMsg struct {
+	redo bool
+}
+
+// nextViewMsg requests switching the list to the next named view
+type nextViewMsg struct{}
+
 // openParentPickerMsg requests opening the parent picker for bean(s)
 type openParentPickerMsg struct {
 	beanIDs       []string // IDs of beans to update
@@ -278,7 +288,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case openParentPickerMsg:
 		// Check if all bean types can have parents
 		for _, beanType := range msg.beanTypes {
-			if beancore.ValidParentTypes(beanType) == nil {
+			if a.config.ValidParentTypes(beanType) == nil {
 				// At least one bean type (e.g., milestone) cannot have parents - don't open the picker
 				return a, nil
 			}
@@ -305,14 +315,17 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a, a.list.loadBeans
 
 	case statusSelectedMsg:
-		// Update all beans' status via GraphQL mutations
+		// Update all beans' status via GraphQL mutations, continuing with
+		// the remaining beans if one fails (e.g. an illegal transition)
+		var failedIDs []string
+		var failedErrs []error
 		for _, beanID := range msg.beanIDs {
 			_, err := a.resolver.UpdateBean(context.Background(), beanID, model.UpdateBeanInput{
 				Status: &msg.status,
 			})
 			if err != nil {
-				// Continue with other beans even if one fails
-				continue
+				failedIDs = append(failedIDs, beanID)
+				failedErrs = append(failedErrs, err)
 			}
 		}
 		// Return to the previous view and refresh
@@ -325,6 +338,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
 			}
 		}
+		if len(failedIDs) > 0 {
+			statusMsg := updateFailureMessage(failedIDs, failedErrs)
+			a.list.statusMessage = statusMsg
+			a.detail.statusMessage = statusMsg
+		}
 		return a, a.list.loadBeans
 
 	case openTypePickerMsg:
@@ -548,6 +566,49 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.list.clearFilter()
 		return a, a.list.loadBeans
 
+	case undoMsg:
+		action, verb, replay := "undo", "Undid", a.core.Undo
+		if msg.redo {
+			action, verb, replay = "redo", "Redid", a.core.Redo
+		}
+		var statusMsg string
+		entries, err := replay(1)
+		switch {
+		case errors.Is(err, beancore.ErrNothingToUndo), errors.Is(err, beancore.ErrNothingToRedo):
+			statusMsg = fmt.Sprintf("Nothing to %s", action)
+		case err != nil:
+			statusMsg = fmt.Sprintf("Cannot %s: %v", action, err)
+		default:
+			statusMsg = fmt.Sprintf("%s %s of %s", verb, entries[0].Op, strings.Join(entries[0].BeanIDs, ", "))
+		}
+		if a.state == viewDetail {
+			updatedBean, err := a.resolver.Bean(context.Background(), a.detail.bean.ID)
+			if err != nil || updatedBean == nil {
+				// The undo removed the bean - return to list
+				a.state = viewList
+				a.history = nil
+			} else {
+				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
+			}
+		}
+		a.list.statusMessage = statusMsg
+		a.detail.statusMessage = statusMsg
+		return a, a.list.loadBeans
+
+	case nextViewMsg:
+		a.list.nextView()
+		switch {
+		case len(a.config.Views) == 0:
+			a.list.statusMessage = "No views declared in " + config.ConfigFileName
+		case a.list.view == nil:
+			a.list.statusMessage = "Showing all beans"
+		case a.list.view.Description != "":
+			a.list.statusMessage = fmt.Sprintf("View: %s (%s)", a.list.view.Name, a.list.view.Description)
+		default:
+			a.list.statusMessage = "View: " + a.list.view.Name
+		}
+		return a, a.list.loadBeans
+
 	case copyBeanIDMsg:
 		var statusMsg string
 		text := strings.Join(msg.ids, ", ")
@@ -701,6 +762,23 @@ func (a *App) getBackgroundView() string {
 	}
 }
 
+// updateFailureMessage summarizes failed bean updates for the footer. When
+// several beans fail, every failed ID is named along with each distinct error.
+func updateFailureMessage(ids []string, errs []error) string {
+	if len(ids) == 1 {
+		return fmt.Sprintf("Failed to update %s: %v", ids[0], errs[0])
+	}
+	var reasons []string
+	seen := make(map[string]bool)
+	for _, err := range errs {
+		if msg := err.Error(); !seen[msg] {
+			seen[msg] = true
+			reasons = append(reasons, msg)
+		}
+	}
+	return fmt.Sprintf("Failed to update %d beans (%s): %s", len(ids), strings.Join(ids, ", "), strings.Join(reasons, "; "))
+}
+
 // getEditor returns the user's preferred editor using the fallback chain:
 // $VISUAL -> $EDITOR -> vi -> nano
 func getEditor() string {
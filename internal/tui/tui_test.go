@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
+)
+
+func TestUndoMsg(t *testing.T) {
+	cfg := config.Default()
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("MkdirAll error = %v", err)
+	}
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	b := &bean.Bean{ID: "undo1", Title: "Undo me", Status: "todo"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	b.Status = "completed"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	app := New(core, cfg)
+	app.Update(undoMsg{})
+	if got, _ := core.Get("undo1"); got.Status != "todo" {
+		t.Errorf("status after undo = %q, want todo", got.Status)
+	}
+	if app.list.statusMessage != "Undid update of undo1" {
+		t.Errorf("statusMessage = %q, want undo confirmation", app.list.statusMessage)
+	}
+
+	app.Update(undoMsg{redo: true})
+	if got, _ := core.Get("undo1"); got.Status != "completed" {
+		t.Errorf("status after redo = %q, want completed", got.Status)
+	}
+	app.Update(undoMsg{redo: true})
+	if app.list.statusMessage != "Nothing to redo" {
+		t.Errorf("statusMessage = %q, want nothing to redo", app.list.statusMessage)
+	}
+}
+
+func TestNextViewMsg(t *testing.T) {
+	cfg := config.Default()
+	cfg.Views = []config.ViewConfig{
+		{Name: "drafts", Filter: map[string]any{"status": []any{"draft"}}},
+		{Name: "bugs", Description: "Open bugs", Filter: map[string]any{"type": []any{"bug"}}},
+	}
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("MkdirAll error = %v", err)
+	}
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, b := range []*bean.Bean{
+		{ID: "draft1", Title: "Draft", Status: "draft", Type: "task"},
+		{ID: "bug1", Title: "Bug", Status: "todo", Type: "bug"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	app := New(core, cfg)
+	for _, want := range []struct {
+		status string
+		beans  int
+	}{
+		{"View: drafts", 1},
+		{"View: bugs (Open bugs)", 1},
+		{"Showing all beans", 2},
+	} {
+		_, cmd := app.Update(nextViewMsg{})
+		if app.list.statusMessage != want.status {
+			t.Errorf("statusMessage = %q, want %q", app.list.statusMessage, want.status)
+		}
+		loaded, ok := cmd().(beansLoadedMsg)
+		if !ok || len(loaded.items) != want.beans {
+			t.Errorf("%s: loaded %d beans, want %d", want.status, len(loaded.items), want.beans)
+		}
+	}
+}
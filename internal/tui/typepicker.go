@@ -82,8 +82,8 @@ type typePickerModel struct {
 }
 
 func newTypePickerModel(beanIDs []string, beanTitle, currentType string, cfg *config.Config, width, height int) typePickerModel {
-	// Get all types (hardcoded in config package)
-	types := config.DefaultTypes
+	// Get all types, including custom types from the config
+	types := cfg.Types()
 
 	delegate := typeItemDelegate{}
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/pkg/config"
 )
 
 // Color palette
@@ -86,6 +87,9 @@ var TagBadge = lipgloss.NewStyle().
 	Background(ColorMuted).
 	Padding(0, 1)
 
+// Assignee style - blue text for "@name" handles
+var Assignee = lipgloss.NewStyle().Foreground(ColorBlue)
+
 // RenderTag renders a single tag as a badge
 func RenderTag(tag string) string {
 	return TagBadge.Render(tag)
@@ -103,6 +107,37 @@ func RenderTags(tags []string) string {
 	return strings.Join(rendered, " ")
 }
 
+// ShortAssignee returns the display form of an assignee: the local part of
+// an email address, or the assignee unchanged.
+func ShortAssignee(assignee string) string {
+	if at := strings.Index(assignee, "@"); at > 0 {
+		return assignee[:at]
+	}
+	return assignee
+}
+
+// RenderAssignees renders assignees as "@name" handles separated by spaces.
+func RenderAssignees(assignees []string) string {
+	handles := make([]string, len(assignees))
+	for i, a := range assignees {
+		handles[i] = "@" + ShortAssignee(a)
+	}
+	return Assignee.Render(strings.Join(handles, " "))
+}
+
+// RenderAssigneesCompact renders the first assignee as a handle for list
+// views, with a "+N" indicator if there are more.
+func RenderAssigneesCompact(assignees []string) string {
+	if len(assignees) == 0 {
+		return ""
+	}
+	text := "@" + ShortAssignee(assignees[0])
+	if len(assignees) > 1 {
+		text += fmt.Sprintf(" +%d", len(assignees)-1)
+	}
+	return Assignee.Render(text)
+}
+
 // RenderTagsCompact renders tags for list views with a max count.
 // Shows up to maxTags badges, with "+N" indicator if there are more.
 // Tags longer than 12 chars are truncated.
@@ -169,32 +204,17 @@ var Header = lipgloss.NewStyle().
 	Bold(true).
 	MarginBottom(1)
 
-// RenderStatus returns a styled status badge based on the status string (legacy, uses hardcoded colors)
-func RenderStatus(status string) string {
-	switch status {
-	case "todo", "draft":
-		return StatusOpen.Render(status)
-	case "completed", "scrapped":
-		return StatusDone.Render(status)
-	case "in-progress", "in_progress":
-		return StatusInProgress.Render(status)
-	default:
-		return Muted.Render(status)
-	}
+// RenderStatus returns a styled status badge using the status color configured in cfg.
+func RenderStatus(cfg *config.Config, status string) string {
+	colors := cfg.GetBeanColors(status, "", "")
+	return RenderStatusWithColor(status, colors.StatusColor, colors.IsArchive)
 }
 
-// RenderStatusText returns styled status text (for tables, no background) (legacy, uses hardcoded colors)
-func RenderStatusText(status string) string {
-	switch status {
-	case "todo", "draft":
-		return StatusOpenText.Render(status)
-	case "completed", "scrapped":
-		return StatusDoneText.Render(status)
-	case "in-progress", "in_progress":
-		return StatusInProgressText.Render("in-progress")
-	default:
-		return Muted.Render(status)
-	}
+// RenderStatusText returns styled status text (for tables, no background) using
+// the status color configured in cfg.
+func RenderStatusText(cfg *config.Config, status string) string {
+	colors := cfg.GetBeanColors(status, "", "")
+	return RenderStatusTextWithColor(status, colors.StatusColor, colors.IsArchive)
 }
 
 // RenderStatusWithColor returns a styled status badge using the specified color.
@@ -365,6 +385,7 @@ type BeanRowConfig struct {
 	IDColWidth      int      // Width of ID column (0 = default of ColWidthID)
 	UseFullNames    bool     // Use full type/status names instead of single-char abbreviations
 	ImplicitStatus string   // Implicit terminal status from an ancestor (e.g., "scrapped")
+	Assignees      []string // Assignees to display after the title (optional)
 }
 
 // Base column widths for bean lists (minimum sizes)
@@ -590,6 +611,9 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 	if cfg.ImplicitStatus != "" && !cfg.Dimmed {
 		implicitAnnotation = Muted.Render(" ↑" + cfg.ImplicitStatus)
 	}
+	if len(cfg.Assignees) > 0 && !cfg.Dimmed {
+		implicitAnnotation += " " + RenderAssigneesCompact(cfg.Assignees)
+	}
 
 	if cfg.ShowTags {
 		// Pad title column to fixed width so tags align in a column
@@ -1,6 +1,10 @@
 package ui
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hmans/beans/pkg/config"
+)
 
 func TestRenderBeanRow_NarrowWidth(t *testing.T) {
 	// Test that RenderBeanRow doesn't panic with very small MaxTitleWidth values
@@ -131,3 +135,15 @@ func TestShortStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderStatusUsesConfiguredColor(t *testing.T) {
+	cfg := config.Default()
+	cfg.CustomStatuses = []config.CustomStatusConfig{{Name: "in-review", Color: "magenta"}}
+
+	if got, want := RenderStatusText(cfg, "in-review"), RenderStatusTextWithColor("in-review", "magenta", false); got != want {
+		t.Errorf("RenderStatusText() = %q, want %q", got, want)
+	}
+	if got, want := RenderStatus(cfg, "completed"), RenderStatusWithColor("completed", "gray", true); got != want {
+		t.Errorf("RenderStatus() = %q, want %q", got, want)
+	}
+}
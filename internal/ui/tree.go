@@ -300,6 +300,7 @@ func renderNode(sb *strings.Builder, node *TreeNode, depth int, isLast bool, anc
 		Dimmed:          !node.Matched,
 		IDColWidth:      renderCfg.treeColWidth,
 		ImplicitStatus: node.ImplicitStatus,
+		Assignees:      b.Assignees,
 	})
 
 	sb.WriteString(row)
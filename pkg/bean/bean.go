@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"maps"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -71,6 +75,114 @@ func (b *Bean) RemoveTag(tag string) {
 	b.Tags = result
 }
 
+// ValidateAssignee checks if an assignee is valid (non-empty, no whitespace).
+// Assignees are typically email addresses or user handles.
+func ValidateAssignee(assignee string) error {
+	if assignee == "" {
+		return fmt.Errorf("assignee cannot be empty")
+	}
+	if strings.ContainsAny(assignee, " \t\n") {
+		return fmt.Errorf("invalid assignee %q: must not contain whitespace", assignee)
+	}
+	return nil
+}
+
+// HasAssignee returns true if the bean is assigned to the given user.
+func (b *Bean) HasAssignee(assignee string) bool {
+	for _, a := range b.Assignees {
+		if strings.EqualFold(a, assignee) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddAssignee assigns the bean to a user if not already assigned.
+// Returns an error if the assignee is invalid.
+func (b *Bean) AddAssignee(assignee string) error {
+	assignee = strings.TrimSpace(assignee)
+	if err := ValidateAssignee(assignee); err != nil {
+		return err
+	}
+	if !b.HasAssignee(assignee) {
+		b.Assignees = append(b.Assignees, assignee)
+	}
+	return nil
+}
+
+// RemoveAssignee unassigns a user from the bean.
+func (b *Bean) RemoveAssignee(assignee string) {
+	result := make([]string, 0, len(b.Assignees))
+	for _, a := range b.Assignees {
+		if !strings.EqualFold(a, strings.TrimSpace(assignee)) {
+			result = append(result, a)
+		}
+	}
+	b.Assignees = result
+}
+
+// HasLink returns true if this bean has a link of the given type to the given bean ID.
+func (b *Bean) HasLink(linkType, id string) bool {
+	return slices.Contains(b.Links[linkType], id)
+}
+
+// AddLink adds a typed link to a bean ID if not already present.
+func (b *Bean) AddLink(linkType, id string) {
+	if b.HasLink(linkType, id) {
+		return
+	}
+	if b.Links == nil {
+		b.Links = make(map[string][]string)
+	}
+	b.Links[linkType] = append(b.Links[linkType], id)
+}
+
+// RemoveLink removes a typed link to a bean ID. Link types left without
+// targets are dropped.
+func (b *Bean) RemoveLink(linkType, id string) {
+	targets := slices.DeleteFunc(slices.Clone(b.Links[linkType]), func(target string) bool {
+		return target == id
+	})
+	if len(targets) == 0 {
+		delete(b.Links, linkType)
+		return
+	}
+	b.Links[linkType] = targets
+}
+
+// LinkTypes returns the types of the bean's typed links, sorted by name.
+func (b *Bean) LinkTypes() []string {
+	types := make([]string, 0, len(b.Links))
+	for linkType := range b.Links {
+		types = append(types, linkType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Comment is one entry in a bean's discussion thread, kept separate from the
+// body so that back-and-forth between agents and humans doesn't clutter the
+// description.
+type Comment struct {
+	Author    string    `yaml:"author,omitempty" json:"author,omitempty"`
+	CreatedAt time.Time `yaml:"created_at" json:"created_at"`
+	Text      string    `yaml:"text" json:"text"`
+}
+
+// AddComment appends a comment to the bean's thread.
+func (b *Bean) AddComment(author, text string, at time.Time) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fmt.Errorf("comment text cannot be empty")
+	}
+	b.Comments = append(b.Comments, Comment{
+		Author:    strings.TrimSpace(author),
+		CreatedAt: at,
+		Text:      text,
+	})
+	return nil
+}
+
 // HasParent returns true if the bean has a parent.
 func (b *Bean) HasParent() bool {
 	return b.Parent != ""
@@ -147,12 +259,31 @@ type Bean struct {
 	Type      string     `yaml:"type,omitempty" json:"type,omitempty"`
 	Priority  string     `yaml:"priority,omitempty" json:"priority,omitempty"`
 	Tags      []string   `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Assignees []string   `yaml:"assignees,omitempty" json:"assignees,omitempty"`
 	CreatedAt *time.Time `yaml:"created_at,omitempty" json:"created_at,omitempty"`
 	UpdatedAt *time.Time `yaml:"updated_at,omitempty" json:"updated_at,omitempty"`
 
+	// StartAt and DueAt are optional planning dates. Date-only values are
+	// stored as midnight UTC.
+	StartAt *time.Time `yaml:"start_at,omitempty" json:"start_at,omitempty"`
+	DueAt   *time.Time `yaml:"due_at,omitempty" json:"due_at,omitempty"`
+
+	// Estimate is the expected effort, in the unit configured in .beans.yml
+	// (points by default). Zero means no estimate.
+	Estimate float64 `yaml:"estimate,omitempty" json:"estimate,omitempty"`
+
 	// Order is a fractional index string for manual sorting.
 	Order string `yaml:"order,omitempty" json:"order,omitempty"`
 
+	// Source references where the bean was imported from, such as the URL of
+	// a GitHub issue. Re-imports use it to update the bean instead of
+	// creating another one.
+	Source string `yaml:"source,omitempty" json:"source,omitempty"`
+
+	// Issue is the number of the forge issue the bean is synced with, or 0
+	// if it isn't synced.
+	Issue int `yaml:"issue,omitempty" json:"issue,omitempty"`
+
 	// Body is the markdown content after the front matter.
 	Body string `yaml:"-" json:"body,omitempty"`
 
@@ -164,21 +295,109 @@ type Bean struct {
 
 	// BlockedBy is a list of bean IDs that are blocking this bean.
 	BlockedBy []string `yaml:"blocked_by,omitempty" json:"blocked_by,omitempty"`
+
+	// Links holds typed relationships to other beans (e.g. relates_to,
+	// duplicates, caused_by), keyed by link type. Link types are declared in
+	// .beans.yml.
+	Links map[string][]string `yaml:"links,omitempty" json:"links,omitempty"`
+
+	// Fields holds custom front-matter values keyed by field name. Values are
+	// kept as strings; their types are declared in .beans.yml.
+	Fields map[string]string `yaml:"-" json:"fields,omitempty"`
+
+	// Comments is the discussion thread, oldest first. It is stored at the
+	// end of the front matter.
+	Comments []Comment `yaml:"-" json:"comments,omitempty"`
+}
+
+// DateFormat is the layout for date-only values such as due dates.
+const DateFormat = "2006-01-02"
+
+// ParseDate parses a planning date given either as YYYY-MM-DD (midnight UTC)
+// or as an RFC 3339 timestamp.
+func ParseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(DateFormat, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD or RFC 3339)", s)
+	}
+	return t, nil
+}
+
+// FormatDate formats a planning date, omitting the time of day for
+// date-only values.
+func FormatDate(t time.Time) string {
+	if isDateOnly(t) {
+		return t.Format(DateFormat)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// isDateOnly reports whether t is midnight UTC, i.e. was given as a date.
+func isDateOnly(t time.Time) bool {
+	return t.Location() == time.UTC && t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// SumEstimates adds up the estimates of the given beans. Scrapped beans are
+// skipped; done accumulates the estimates of beans whose status isDone
+// reports as finished.
+func SumEstimates(beans []*Bean, isDone func(status string) bool) (done, total float64) {
+	for _, b := range beans {
+		if b.Status == "scrapped" {
+			continue
+		}
+		total += b.Estimate
+		if isDone(b.Status) {
+			done += b.Estimate
+		}
+	}
+	return done, total
+}
+
+// FormatEstimate formats an estimate without redundant decimal places.
+func FormatEstimate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// SetField sets a custom field value. An empty value removes the field.
+func (b *Bean) SetField(name, value string) {
+	if value == "" {
+		delete(b.Fields, name)
+		return
+	}
+	if b.Fields == nil {
+		b.Fields = make(map[string]string)
+	}
+	b.Fields[name] = value
 }
 
 // frontMatter is the subset of Bean that gets serialized to YAML front matter.
 type frontMatter struct {
-	Title     string     `yaml:"title"`
-	Status    string     `yaml:"status"`
-	Type      string     `yaml:"type,omitempty"`
-	Priority  string     `yaml:"priority,omitempty"`
-	Tags      []string   `yaml:"tags,omitempty"`
-	CreatedAt *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt *time.Time `yaml:"updated_at,omitempty"`
-	Order     string     `yaml:"order,omitempty"`
-	Parent    string     `yaml:"parent,omitempty"`
-	Blocking  []string   `yaml:"blocking,omitempty"`
-	BlockedBy []string   `yaml:"blocked_by,omitempty"`
+	Title     string              `yaml:"title"`
+	Status    string              `yaml:"status"`
+	Type      string              `yaml:"type,omitempty"`
+	Priority  string              `yaml:"priority,omitempty"`
+	Tags      []string            `yaml:"tags,omitempty"`
+	Assignees []string            `yaml:"assignees,omitempty"`
+	CreatedAt *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time          `yaml:"updated_at,omitempty"`
+	StartAt   *time.Time          `yaml:"start_at,omitempty"`
+	DueAt     *time.Time          `yaml:"due_at,omitempty"`
+	Estimate  float64             `yaml:"estimate,omitempty"`
+	Order     string              `yaml:"order,omitempty"`
+	Source    string              `yaml:"source,omitempty"`
+	Issue     int                 `yaml:"issue,omitempty"`
+	Parent    string              `yaml:"parent,omitempty"`
+	Blocking  []string            `yaml:"blocking,omitempty"`
+	BlockedBy []string            `yaml:"blocked_by,omitempty"`
+	Links     map[string][]string `yaml:"links,omitempty"`
+	Comments  []Comment           `yaml:"comments,omitempty"`
+
+	// Extra collects all keys not listed above (custom fields).
+	Extra map[string]interface{} `yaml:",inline"`
 }
 
 // Parse reads a bean from a reader (markdown with YAML front matter).
@@ -198,29 +417,73 @@ func Parse(r io.Reader) (*Bean, error) {
 		Type:      fm.Type,
 		Priority:  fm.Priority,
 		Tags:      fm.Tags,
+		Assignees: fm.Assignees,
 		CreatedAt: fm.CreatedAt,
 		UpdatedAt: fm.UpdatedAt,
+		StartAt:   fm.StartAt,
+		DueAt:     fm.DueAt,
+		Estimate:  fm.Estimate,
 		Order:     fm.Order,
+		Source:    fm.Source,
+		Issue:     fm.Issue,
 		Body:      bodyStr,
 		Parent:    fm.Parent,
 		Blocking:  fm.Blocking,
 		BlockedBy: fm.BlockedBy,
+		Links:     fm.Links,
+		Fields:    extraFields(fm.Extra),
+		Comments:  fm.Comments,
 	}, nil
 }
 
+// extraFields converts unknown scalar front-matter keys into custom field
+// values. Nested values and nulls are dropped.
+func extraFields(extra map[string]interface{}) map[string]string {
+	var fields map[string]string
+	for key, raw := range extra {
+		var value string
+		switch v := raw.(type) {
+		case string:
+			value = v
+		case int:
+			value = strconv.Itoa(v)
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			value = strconv.FormatBool(v)
+		case time.Time:
+			value = v.Format(time.RFC3339)
+		default:
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]string)
+		}
+		fields[key] = value
+	}
+	return fields
+}
+
 // renderFrontMatter is used for YAML output with yaml.v3 (supports custom marshalers).
 type renderFrontMatter struct {
-	Title     string     `yaml:"title"`
-	Status    string     `yaml:"status"`
-	Type      string     `yaml:"type,omitempty"`
-	Priority  string     `yaml:"priority,omitempty"`
-	Tags      []string   `yaml:"tags,omitempty"`
-	CreatedAt *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt *time.Time `yaml:"updated_at,omitempty"`
-	Order     string     `yaml:"order,omitempty"`
-	Parent    string     `yaml:"parent,omitempty"`
-	Blocking  []string   `yaml:"blocking,omitempty"`
-	BlockedBy []string   `yaml:"blocked_by,omitempty"`
+	Title     string              `yaml:"title"`
+	Status    string              `yaml:"status"`
+	Type      string              `yaml:"type,omitempty"`
+	Priority  string              `yaml:"priority,omitempty"`
+	Tags      []string            `yaml:"tags,omitempty"`
+	Assignees []string            `yaml:"assignees,omitempty"`
+	CreatedAt *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt *time.Time          `yaml:"updated_at,omitempty"`
+	StartAt   *yaml.Node          `yaml:"start_at,omitempty"`
+	DueAt     *yaml.Node          `yaml:"due_at,omitempty"`
+	Estimate  float64             `yaml:"estimate,omitempty"`
+	Order     string              `yaml:"order,omitempty"`
+	Source    string              `yaml:"source,omitempty"`
+	Issue     int                 `yaml:"issue,omitempty"`
+	Parent    string              `yaml:"parent,omitempty"`
+	Blocking  []string            `yaml:"blocking,omitempty"`
+	BlockedBy []string            `yaml:"blocked_by,omitempty"`
+	Links     map[string][]string `yaml:"links,omitempty"`
 }
 
 // Render serializes the bean back to markdown with YAML front matter.
@@ -231,15 +494,37 @@ func (b *Bean) Render() ([]byte, error) {
 		Type:      b.Type,
 		Priority:  b.Priority,
 		Tags:      b.Tags,
+		Assignees: b.Assignees,
 		CreatedAt: b.CreatedAt,
 		UpdatedAt: b.UpdatedAt,
+		StartAt:   dateNode(b.StartAt),
+		DueAt:     dateNode(b.DueAt),
+		Estimate:  b.Estimate,
 		Order:     b.Order,
+		Source:    b.Source,
+		Issue:     b.Issue,
 		Parent:    b.Parent,
 		Blocking:  b.Blocking,
 		BlockedBy: b.BlockedBy,
+		Links:     b.Links,
+	}
+
+	var fmNode yaml.Node
+	if err := fmNode.Encode(&fm); err != nil {
+		return nil, fmt.Errorf("marshaling front matter: %w", err)
+	}
+	fmNode.Content = append(fmNode.Content, fieldNodes(b.Fields)...)
+
+	// Comments go last so the thread reads top to bottom after the metadata
+	if len(b.Comments) > 0 {
+		var comments yaml.Node
+		if err := comments.Encode(b.Comments); err != nil {
+			return nil, fmt.Errorf("marshaling comments: %w", err)
+		}
+		fmNode.Content = append(fmNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "comments"}, &comments)
 	}
 
-	fmBytes, err := yaml.Marshal(&fm)
+	fmBytes, err := yaml.Marshal(&fmNode)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling front matter: %w", err)
 	}
@@ -271,6 +556,39 @@ func (b *Bean) Render() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// dateNode renders a planning date as a plain YAML timestamp, so date-only
+// values read as "2024-03-01" rather than a full timestamp.
+func dateNode(t *time.Time) *yaml.Node {
+	if t == nil {
+		return nil
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!timestamp", Value: FormatDate(*t)}
+}
+
+// plainFieldPattern matches custom field values that are written unquoted
+// (canonical numbers and dates), so they read naturally in the front matter.
+// Anything that would not parse back to the same string is quoted instead.
+var plainFieldPattern = regexp.MustCompile(`^(-?(0|[1-9][0-9]*)(\.[0-9]*[1-9])?|[0-9]{4}-[0-9]{2}-[0-9]{2})$`)
+
+// fieldNodes returns YAML key/value nodes for custom fields, sorted by name.
+func fieldNodes(fields map[string]string) []*yaml.Node {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodes := make([]*yaml.Node, 0, len(names)*2)
+	for _, name := range names {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Value: fields[name]}
+		if !plainFieldPattern.MatchString(fields[name]) {
+			_ = value.Encode(fields[name])
+		}
+		nodes = append(nodes, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, value)
+	}
+	return nodes
+}
+
 // ETag returns a hash of the bean's rendered content for optimistic concurrency control.
 // Uses FNV-1a 64-bit hash, producing a 16-character hex string.
 // Returns "0000000000000000" if rendering fails (should never happen for valid beans).
@@ -286,6 +604,33 @@ func (b *Bean) ETag() string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// Clone returns a deep copy of the bean, so the copy can be modified without
+// affecting the original.
+func (b *Bean) Clone() *Bean {
+	c := *b
+	c.Tags = slices.Clone(b.Tags)
+	c.Assignees = slices.Clone(b.Assignees)
+	c.Blocking = slices.Clone(b.Blocking)
+	c.BlockedBy = slices.Clone(b.BlockedBy)
+	c.Comments = slices.Clone(b.Comments)
+	for _, t := range []**time.Time{&c.CreatedAt, &c.UpdatedAt, &c.StartAt, &c.DueAt} {
+		if *t != nil {
+			v := **t
+			*t = &v
+		}
+	}
+	if b.Links != nil {
+		c.Links = make(map[string][]string, len(b.Links))
+		for linkType, ids := range b.Links {
+			c.Links[linkType] = slices.Clone(ids)
+		}
+	}
+	if b.Fields != nil {
+		c.Fields = maps.Clone(b.Fields)
+	}
+	return &c
+}
+
 // MarshalJSON implements json.Marshaler to include computed etag field.
 func (b *Bean) MarshalJSON() ([]byte, error) {
 	type BeanAlias Bean // Avoid infinite recursion
@@ -1368,6 +1368,327 @@ func TestTagsRoundtrip(t *testing.T) {
 	}
 }
 
+func TestSourceRoundtrip(t *testing.T) {
+	b := &Bean{Title: "Test", Status: "todo", Source: "https://github.com/acme/app/issues/12"}
+
+	output, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(output), "\nsource: https://github.com/acme/app/issues/12\n") {
+		t.Errorf("Render() = %s, want a source line", output)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(output)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if parsed.Source != b.Source {
+		t.Errorf("Source = %q, want %q", parsed.Source, b.Source)
+	}
+	if len(parsed.Fields) != 0 {
+		t.Errorf("Fields = %v, want source not to be a custom field", parsed.Fields)
+	}
+}
+
+func TestIssueRoundtrip(t *testing.T) {
+	b := &Bean{Title: "Test", Status: "todo", Issue: 42}
+
+	output, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(output), "\nissue: 42\n") {
+		t.Errorf("Render() = %s, want an issue line", output)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(output)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if parsed.Issue != 42 {
+		t.Errorf("Issue = %d, want 42", parsed.Issue)
+	}
+	if len(parsed.Fields) != 0 {
+		t.Errorf("Fields = %v, want issue not to be a custom field", parsed.Fields)
+	}
+}
+
+func TestParseWithCustomFields(t *testing.T) {
+	input := `---
+title: Test
+status: todo
+points: 3
+ratio: 2.50
+due: 2024-03-01
+owner: alice@example.com
+flag: true
+nested:
+  a: b
+empty:
+---
+`
+	b, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	want := map[string]string{
+		"points": "3",
+		"ratio":  "2.5",
+		"due":    "2024-03-01",
+		"owner":  "alice@example.com",
+		"flag":   "true",
+	}
+	if len(b.Fields) != len(want) {
+		t.Fatalf("Fields = %v, want %v", b.Fields, want)
+	}
+	for k, v := range want {
+		if b.Fields[k] != v {
+			t.Errorf("Fields[%q] = %q, want %q", k, b.Fields[k], v)
+		}
+	}
+}
+
+func TestRenderWithCustomFields(t *testing.T) {
+	b := &Bean{
+		Title:  "Test",
+		Status: "todo",
+		Tags:   []string{"a"},
+		Fields: map[string]string{
+			"url":    "https://example.com/x?a=b#c",
+			"points": "3",
+			"due":    "2024-03-01",
+			"answer": "yes",
+		},
+	}
+
+	output, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	want := `---
+title: Test
+status: todo
+tags:
+    - a
+answer: "yes"
+due: 2024-03-01
+points: 3
+url: https://example.com/x?a=b#c
+---
+`
+	if string(output) != want+"\n" {
+		t.Errorf("Render() =\n%s\nwant:\n%s", output, want)
+	}
+}
+
+func TestCustomFieldsRoundtrip(t *testing.T) {
+	fields := map[string]string{
+		"points":   "13",
+		"effort":   "1.5",
+		"due":      "2024-03-01",
+		"owner":    "alice@example.com",
+		"answer":   "no",
+		"count":    "007",
+		"version":  "1.10",
+		"note":     "a: b # not a comment",
+		"link":     "https://example.com",
+	}
+	original := &Bean{Title: "Test", Status: "todo", Fields: fields}
+
+	rendered, err := original.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+
+	for k, v := range fields {
+		if parsed.Fields[k] != v {
+			t.Errorf("Fields[%q] = %q, want %q\n%s", k, parsed.Fields[k], v, rendered)
+		}
+	}
+}
+
+func TestSetField(t *testing.T) {
+	b := &Bean{}
+	b.SetField("points", "3")
+	if b.Fields["points"] != "3" {
+		t.Errorf("Fields[points] = %q, want %q", b.Fields["points"], "3")
+	}
+	b.SetField("points", "")
+	if _, ok := b.Fields["points"]; ok {
+		t.Error("SetField with empty value should remove the field")
+	}
+}
+
+func TestAssignees(t *testing.T) {
+	b := &Bean{}
+	if err := b.AddAssignee(" alice@example.com "); err != nil {
+		t.Fatalf("AddAssignee() error = %v", err)
+	}
+	if err := b.AddAssignee("ALICE@example.com"); err != nil {
+		t.Fatalf("AddAssignee() error = %v", err)
+	}
+	if err := b.AddAssignee("bob"); err != nil {
+		t.Fatalf("AddAssignee() error = %v", err)
+	}
+	if len(b.Assignees) != 2 || b.Assignees[0] != "alice@example.com" {
+		t.Fatalf("Assignees = %v, want [alice@example.com bob]", b.Assignees)
+	}
+	if err := b.AddAssignee("alice smith"); err == nil {
+		t.Error("AddAssignee() should reject names with whitespace")
+	}
+
+	rendered, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if !parsed.HasAssignee("alice@example.com") || !parsed.HasAssignee("bob") {
+		t.Errorf("parsed Assignees = %v, want alice and bob\n%s", parsed.Assignees, rendered)
+	}
+
+	b.RemoveAssignee("Alice@Example.com")
+	if len(b.Assignees) != 1 || b.Assignees[0] != "bob" {
+		t.Errorf("Assignees after remove = %v, want [bob]", b.Assignees)
+	}
+}
+
+func TestPlanningDatesRoundtrip(t *testing.T) {
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 2, 1, 9, 30, 0, 0, time.UTC)
+	original := &Bean{Title: "Test", Status: "todo", StartAt: &start, DueAt: &due}
+
+	rendered, err := original.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(rendered), "due_at: 2024-03-01\n") {
+		t.Errorf("date-only due_at should render without a time:\n%s", rendered)
+	}
+	if !strings.Contains(string(rendered), "start_at: 2024-02-01T09:30:00Z\n") {
+		t.Errorf("start_at should render as a timestamp:\n%s", rendered)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if parsed.DueAt == nil || !parsed.DueAt.Equal(due) {
+		t.Errorf("DueAt = %v, want %v", parsed.DueAt, due)
+	}
+	if parsed.StartAt == nil || !parsed.StartAt.Equal(start) {
+		t.Errorf("StartAt = %v, want %v", parsed.StartAt, start)
+	}
+}
+
+func TestLinks(t *testing.T) {
+	b := &Bean{Title: "Test", Status: "todo"}
+	b.AddLink("relates_to", "abc")
+	b.AddLink("relates_to", "abc")
+	b.AddLink("duplicates", "def")
+	if !b.HasLink("relates_to", "abc") || len(b.Links["relates_to"]) != 1 {
+		t.Errorf("Links = %v, want a single relates_to link to abc", b.Links)
+	}
+	if got := b.LinkTypes(); len(got) != 2 || got[0] != "duplicates" || got[1] != "relates_to" {
+		t.Errorf("LinkTypes() = %v, want [duplicates relates_to]", got)
+	}
+
+	rendered, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(rendered), "links:\n    duplicates:\n        - def\n    relates_to:\n        - abc\n") {
+		t.Errorf("links rendered unexpectedly:\n%s", rendered)
+	}
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if !parsed.HasLink("duplicates", "def") || !parsed.HasLink("relates_to", "abc") {
+		t.Errorf("parsed Links = %v", parsed.Links)
+	}
+	if _, ok := parsed.Fields["links"]; ok {
+		t.Error("links should not be parsed as a custom field")
+	}
+
+	b.RemoveLink("duplicates", "def")
+	if _, ok := b.Links["duplicates"]; ok {
+		t.Errorf("Links = %v, empty link types should be dropped", b.Links)
+	}
+}
+
+func TestCommentsRoundtrip(t *testing.T) {
+	original := &Bean{Title: "Test", Status: "todo", Body: "Description", Fields: map[string]string{"team": "core"}}
+	at := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	if err := original.AddComment("alice@example.com", "Looks good.\n\nOne nit below.", at); err != nil {
+		t.Fatalf("AddComment error: %v", err)
+	}
+	if err := original.AddComment("", "  ", at); err == nil {
+		t.Error("AddComment with empty text should fail")
+	}
+
+	rendered, err := original.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(rendered), "team: core\ncomments:\n") {
+		t.Errorf("comments should be rendered after custom fields:\n%s", rendered)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if len(parsed.Comments) != 1 {
+		t.Fatalf("Comments = %+v, want 1 comment", parsed.Comments)
+	}
+	c := parsed.Comments[0]
+	if c.Author != "alice@example.com" || !c.CreatedAt.Equal(at) || c.Text != "Looks good.\n\nOne nit below." {
+		t.Errorf("Comment = %+v", c)
+	}
+	if strings.TrimSpace(parsed.Body) != "Description" {
+		t.Errorf("Body = %q, want comments kept out of the body", parsed.Body)
+	}
+	if _, ok := parsed.Fields["comments"]; ok {
+		t.Error("comments should not be parsed as a custom field")
+	}
+}
+
+func TestSumEstimates(t *testing.T) {
+	beans := []*Bean{
+		{Status: "completed", Estimate: 3},
+		{Status: "todo", Estimate: 1.5},
+		{Status: "scrapped", Estimate: 8},
+		{Status: "todo"},
+	}
+	done, total := SumEstimates(beans, func(status string) bool { return status == "completed" })
+	if done != 3 || total != 4.5 {
+		t.Errorf("SumEstimates() = %v/%v, want 3/4.5", done, total)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	got, err := ParseDate("2024-03-01")
+	if err != nil || !got.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseDate(date) = %v, %v", got, err)
+	}
+	got, err = ParseDate("2024-03-01T12:00:00+02:00")
+	if err != nil || !got.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseDate(RFC 3339) = %v, %v", got, err)
+	}
+	if _, err := ParseDate("03/01/2024"); err == nil {
+		t.Error("ParseDate() should reject other formats")
+	}
+}
+
 func TestRenderWithIDComment(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1709,3 +2030,27 @@ func TestETagChangesAfterModification(t *testing.T) {
 		t.Error("JSON etag should differ after modification")
 	}
 }
+
+func TestClone(t *testing.T) {
+	due := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	b := &Bean{
+		ID:     "abc1",
+		Title:  "Original",
+		Tags:   []string{"auth"},
+		DueAt:  &due,
+		Links:  map[string][]string{"relates_to": {"def2"}},
+		Fields: map[string]string{"team": "core"},
+	}
+
+	c := b.Clone()
+	c.Title = "Copy"
+	c.Tags[0] = "ui"
+	*c.DueAt = due.AddDate(0, 0, 1)
+	c.Links["relates_to"][0] = "ghi3"
+	c.Fields["team"] = "web"
+
+	if b.Title != "Original" || b.Tags[0] != "auth" || !b.DueAt.Equal(due) ||
+		b.Links["relates_to"][0] != "def2" || b.Fields["team"] != "core" {
+		t.Errorf("modifying the clone changed the original: %+v", b)
+	}
+}
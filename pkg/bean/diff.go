@@ -0,0 +1,155 @@
+package bean
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldChange describes how a front-matter field or the body differs between
+// two versions of a bean. Scalar fields use From/To; list fields and the body
+// use Added/Removed (for the body, these are the added and removed lines).
+type FieldChange struct {
+	Field   string   `json:"field"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// Diff returns the changes from old to new in front-matter order. A nil old
+// reports every field set on new as a change from empty. Timestamps and the
+// manual sort order are ignored, since they change on nearly every write.
+func Diff(old, new *Bean) []FieldChange {
+	if old == nil {
+		old = &Bean{}
+	}
+
+	var changes []FieldChange
+	scalar := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, FieldChange{Field: field, From: from, To: to})
+		}
+	}
+	list := func(field string, from, to []string) {
+		added, removed := diffLists(from, to)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, FieldChange{Field: field, Added: added, Removed: removed})
+		}
+	}
+
+	scalar("title", old.Title, new.Title)
+	scalar("status", old.Status, new.Status)
+	scalar("type", old.Type, new.Type)
+	scalar("priority", old.Priority, new.Priority)
+	list("tags", old.Tags, new.Tags)
+	list("assignees", old.Assignees, new.Assignees)
+	scalar("start_at", formatOptionalDate(old.StartAt), formatOptionalDate(new.StartAt))
+	scalar("due_at", formatOptionalDate(old.DueAt), formatOptionalDate(new.DueAt))
+	scalar("estimate", formatOptionalEstimate(old.Estimate), formatOptionalEstimate(new.Estimate))
+	scalar("source", old.Source, new.Source)
+	scalar("issue", formatOptionalIssue(old.Issue), formatOptionalIssue(new.Issue))
+	scalar("parent", old.Parent, new.Parent)
+	list("blocking", old.Blocking, new.Blocking)
+	list("blocked_by", old.BlockedBy, new.BlockedBy)
+
+	linkTypes := new.LinkTypes()
+	for _, linkType := range old.LinkTypes() {
+		if _, ok := new.Links[linkType]; !ok {
+			linkTypes = append(linkTypes, linkType)
+		}
+	}
+	sort.Strings(linkTypes)
+	for _, linkType := range linkTypes {
+		list("links."+linkType, old.Links[linkType], new.Links[linkType])
+	}
+
+	names := make([]string, 0, len(old.Fields)+len(new.Fields))
+	for name := range old.Fields {
+		names = append(names, name)
+	}
+	for name := range new.Fields {
+		if _, ok := old.Fields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		scalar(name, old.Fields[name], new.Fields[name])
+	}
+
+	list("comments", commentLines(old.Comments), commentLines(new.Comments))
+
+	if old.Body != new.Body {
+		added, removed := diffLists(bodyLines(old.Body), bodyLines(new.Body))
+		changes = append(changes, FieldChange{Field: "body", Added: added, Removed: removed})
+	}
+
+	return changes
+}
+
+// diffLists returns the entries of to missing from from (added) and the
+// entries of from missing from to (removed), counting duplicates.
+func diffLists(from, to []string) (added, removed []string) {
+	counts := make(map[string]int, len(from))
+	for _, v := range from {
+		counts[v]++
+	}
+	for _, v := range to {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		added = append(added, v)
+	}
+	for _, v := range from {
+		if counts[v] > 0 {
+			counts[v]--
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// bodyLines splits a body into lines, ignoring blank ones.
+func bodyLines(body string) []string {
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// commentLines formats comments as "author: text" for diffing.
+func commentLines(comments []Comment) []string {
+	lines := make([]string, len(comments))
+	for i, c := range comments {
+		lines[i] = c.Author + ": " + c.Text
+	}
+	return lines
+}
+
+func formatOptionalDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return FormatDate(*t)
+}
+
+func formatOptionalEstimate(v float64) string {
+	if v == 0 {
+		return ""
+	}
+	return FormatEstimate(v)
+}
+
+// formatOptionalIssue formats an issue number for display, or "" if unset.
+func formatOptionalIssue(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return "#" + strconv.Itoa(n)
+}
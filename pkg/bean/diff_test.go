@@ -0,0 +1,45 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old := &Bean{
+		Title:  "Login",
+		Status: "todo",
+		Tags:   []string{"auth", "frontend"},
+		Body:   "First line\nSecond line",
+		Fields: map[string]string{"points": "3"},
+	}
+	new := &Bean{
+		Title:    "Login",
+		Status:   "in-progress",
+		Tags:     []string{"auth", "backend"},
+		Body:     "First line\nThird line",
+		Estimate: 2,
+		Fields:   map[string]string{"team": "core"},
+	}
+
+	want := []FieldChange{
+		{Field: "status", From: "todo", To: "in-progress"},
+		{Field: "tags", Added: []string{"backend"}, Removed: []string{"frontend"}},
+		{Field: "estimate", To: "2"},
+		{Field: "points", From: "3"},
+		{Field: "team", To: "core"},
+		{Field: "body", Added: []string{"Third line"}, Removed: []string{"Second line"}},
+	}
+	if got := Diff(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := Diff(new, new); len(got) != 0 {
+		t.Errorf("Diff() of identical beans = %+v, want none", got)
+	}
+
+	created := Diff(nil, &Bean{Title: "New", Status: "todo"})
+	if len(created) != 2 || created[0].Field != "title" || created[1].To != "todo" {
+		t.Errorf("Diff(nil, ...) = %+v, want title and status set", created)
+	}
+}
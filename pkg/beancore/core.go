@@ -16,12 +16,18 @@ import (
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/config"
+	"github.com/hmans/beans/internal/embedding"
+	"github.com/hmans/beans/internal/gitutil"
 	"github.com/hmans/beans/internal/search"
 )
 
 const BeansDir = ".beans"
 const ArchiveDir = "archive"
 
+// CacheDir is the directory inside the beans directory for derived data that
+// can be rebuilt at any time, such as the persistent search index.
+const CacheDir = ".cache"
+
 var ErrNotFound = errors.New("bean not found")
 
 // ETagMismatchError is returned when an ETag validation fails.
@@ -53,9 +59,23 @@ type Core struct {
 	dirty          map[string]bool       // IDs of beans modified in runtime but not yet persisted to disk
 	worktreeLinks  map[string]string     // bean ID -> worktree path (beans linked to a worktree)
 
+	// overlay holds staged file contents by path relative to root, for the
+	// staged copy of the graph inside a transaction. Writes go to the overlay
+	// instead of disk; a nil entry marks a deleted file. Nil outside
+	// transactions.
+	overlay map[string][]byte
+
+	// journalGroup collects journal changes to record as one operation
+	// (see beginJournalGroup). Nil when not grouping.
+	journalGroup *[]FileChange
+
 	// Search index (optional, lazy-initialized)
 	searchIndex *search.Index
 
+	// Similarity index (lazy-initialized, rebuilt when bean texts change)
+	similarity   *embedding.Index
+	similarityMu sync.Mutex
+
 	// File watching (optional)
 	watching          bool
 	done              chan struct{}
@@ -115,6 +135,20 @@ func (c *Core) Config() *config.Config {
 	return c.config
 }
 
+// CurrentUser returns the identity of the person (or agent) using beans,
+// used for assignment and "mine" filtering. Precedence: BEANS_USER env var >
+// beans.user config > git user.email of the project. Returns an empty string
+// if no identity is configured.
+func (c *Core) CurrentUser() string {
+	if user := strings.TrimSpace(os.Getenv("BEANS_USER")); user != "" {
+		return user
+	}
+	if c.config != nil && c.config.Beans.User != "" {
+		return c.config.Beans.User
+	}
+	return gitutil.UserEmail(filepath.Dir(c.root))
+}
+
 // Load reads all beans from disk into memory.
 func (c *Core) Load() error {
 	c.mu.Lock()
@@ -234,13 +268,30 @@ func (c *Core) loadBean(path string) (*bean.Bean, error) {
 	return b, nil
 }
 
-// ensureSearchIndexLocked initializes the in-memory search index if not already created.
+// ensureSearchIndexLocked initializes the search index if not already created.
 // Must be called with lock held or from a method that holds the lock.
 func (c *Core) ensureSearchIndexLocked() error {
 	if c.searchIndex != nil {
 		return nil
 	}
 
+	allBeans := make([]*bean.Bean, 0, len(c.beans))
+	for _, b := range c.beans {
+		allBeans = append(allBeans, b)
+	}
+
+	// Bring the persistent index up to date, falling back to an in-memory
+	// rebuild if that fails
+	if idx := c.openPersistentSearchIndex(); idx != nil {
+		_, err := idx.Sync(allBeans)
+		if err == nil {
+			c.searchIndex = idx
+			return nil
+		}
+		c.logWarn("failed to update search index, rebuilding in memory: %v", err)
+		idx.Close()
+	}
+
 	idx, err := search.NewIndex()
 	if err != nil {
 		return fmt.Errorf("initializing search index: %w", err)
@@ -249,10 +300,6 @@ func (c *Core) ensureSearchIndexLocked() error {
 	c.searchIndex = idx
 
 	// Populate the in-memory index with existing beans
-	allBeans := make([]*bean.Bean, 0, len(c.beans))
-	for _, b := range c.beans {
-		allBeans = append(allBeans, b)
-	}
 	if err := c.searchIndex.IndexBeans(allBeans); err != nil {
 		return fmt.Errorf("populating search index: %w", err)
 	}
@@ -260,18 +307,53 @@ func (c *Core) ensureSearchIndexLocked() error {
 	return nil
 }
 
+// openPersistentSearchIndex opens the on-disk search index under .cache if
+// search.persistent_index is enabled. Returns nil if it is disabled, if this
+// is the staged core of a transaction (whose beans aren't on disk yet), or if
+// the index can't be opened, e.g. because another beans process has it open.
+func (c *Core) openPersistentSearchIndex() *search.Index {
+	if c.config == nil || !c.config.Search.PersistentIndex || c.overlay != nil {
+		return nil
+	}
+	cacheDir, err := c.ensureCacheDir()
+	if err != nil {
+		c.logWarn("failed to create cache directory, rebuilding search index in memory: %v", err)
+		return nil
+	}
+	idx, err := search.OpenIndex(filepath.Join(cacheDir, "search"), c.root)
+	if err != nil {
+		if !errors.Is(err, search.ErrIndexLocked) {
+			c.logWarn("failed to open search index, rebuilding in memory: %v", err)
+		}
+		return nil
+	}
+	return idx
+}
+
+// ensureCacheDir creates the cache directory if needed and returns its path.
+// The directory ignores itself in git, so that projects whose .gitignore
+// predates it don't commit the cache.
+func (c *Core) ensureCacheDir() (string, error) {
+	dir := filepath.Join(c.root, CacheDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	gitignore := filepath.Join(dir, ".gitignore")
+	if _, err := os.Stat(gitignore); os.IsNotExist(err) {
+		if err := os.WriteFile(gitignore, []byte("*\n"), 0644); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
 // Search performs full-text search and returns matching beans.
 // The search index is lazily initialized on first use.
 func (c *Core) Search(query string) ([]*bean.Bean, error) {
-	// Ensure index is initialized (needs write lock for lazy init)
-	c.mu.Lock()
-	if err := c.ensureSearchIndexLocked(); err != nil {
-		c.mu.Unlock()
+	idx, err := c.initSearchIndex()
+	if err != nil {
 		return nil, err
 	}
-	// Capture searchIndex reference while holding lock
-	idx := c.searchIndex
-	c.mu.Unlock()
 
 	// Perform search outside the lock (Bleve is thread-safe)
 	ids, err := idx.Search(query, search.DefaultSearchLimit)
@@ -292,6 +374,51 @@ func (c *Core) Search(query string) ([]*bean.Bean, error) {
 	return result, nil
 }
 
+// SearchHit is a bean matching a full-text search, with its relevance score
+// and highlighted fragments by field name.
+type SearchHit struct {
+	Bean       *bean.Bean
+	Score      float64
+	Highlights map[string][]string
+}
+
+// SearchHits performs full-text search like Search, but returns up to limit
+// hits (0 for the default limit) with scores and highlights, best match
+// first. Archived beans are included.
+func (c *Core) SearchHits(query string, limit int) ([]SearchHit, error) {
+	idx, err := c.initSearchIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := idx.SearchHits(query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := make([]SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		if b, ok := c.beans[hit.ID]; ok {
+			result = append(result, SearchHit{Bean: b, Score: hit.Score, Highlights: hit.Highlights})
+		}
+	}
+	return result, nil
+}
+
+// initSearchIndex initializes the search index if needed and returns it.
+func (c *Core) initSearchIndex() (*search.Index, error) {
+	// Ensure index is initialized (needs write lock for lazy init)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.ensureSearchIndexLocked(); err != nil {
+		return nil, err
+	}
+	return c.searchIndex, nil
+}
+
 // All returns a slice of all beans.
 func (c *Core) All() []*bean.Bean {
 	c.mu.RLock()
@@ -563,8 +690,7 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string, opts ...UpdateOption) error
 		var currentETag string
 		if storedBean.Path != "" && !c.dirty[b.ID] {
 			// Read current file from disk to calculate etag
-			diskPath := filepath.Join(c.root, storedBean.Path)
-			content, err := os.ReadFile(diskPath)
+			content, err := c.readFile(storedBean.Path)
 			if err != nil {
 				// If file doesn't exist yet, fall back to stored bean's etag
 				currentETag = storedBean.ETag()
@@ -640,27 +766,51 @@ func (c *Core) saveToDisk(b *bean.Bean) error {
 		b.Path = filename
 	}
 
-	// Ensure parent directory exists
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("creating directory: %w", err)
-	}
-
 	// Render and write
 	content, err := b.Render()
 	if err != nil {
 		return err
 	}
 
+	if c.overlay != nil {
+		c.overlay[b.Path] = content
+		return nil
+	}
+
+	// Ensure parent directory exists
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	before, _ := os.ReadFile(path)
 	if err := os.WriteFile(path, content, 0644); err != nil {
 		return fmt.Errorf("writing file: %w", err)
 	}
+	if string(before) != string(content) {
+		c.record("", FileChange{Path: b.Path, Before: string(before), After: string(content)})
+	}
 
 	return nil
 }
 
+// readFile returns the content of a bean file given relative to root,
+// preferring staged content inside a transaction.
+func (c *Core) readFile(relPath string) ([]byte, error) {
+	if content, ok := c.overlay[relPath]; ok {
+		if content == nil {
+			return nil, os.ErrNotExist
+		}
+		return content, nil
+	}
+	return os.ReadFile(filepath.Join(c.root, relPath))
+}
+
 // saveToWorktree writes a bean to a worktree's .beans/ directory.
 func (c *Core) saveToWorktree(b *bean.Bean, worktreePath string) error {
+	if c.overlay != nil {
+		return ErrNotInTx
+	}
 	beansDir := filepath.Join(worktreePath, BeansDir)
 
 	// Ensure the .beans/ directory exists in the worktree
@@ -708,9 +858,15 @@ func (c *Core) Delete(id string) error {
 	}
 
 	// Remove from disk
-	path := filepath.Join(c.root, targetBean.Path)
-	if err := os.Remove(path); err != nil {
-		return err
+	if c.overlay != nil {
+		c.overlay[targetBean.Path] = nil
+	} else {
+		path := filepath.Join(c.root, targetBean.Path)
+		before, _ := os.ReadFile(path)
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		c.record(OpDelete, FileChange{Path: targetBean.Path, Before: string(before)})
 	}
 
 	// Remove from in-memory map
@@ -729,6 +885,9 @@ func (c *Core) Delete(id string) error {
 // Archive moves a bean to the archive directory.
 // Supports short IDs (without prefix) if a prefix is configured.
 func (c *Core) Archive(id string) error {
+	if c.overlay != nil {
+		return ErrNotInTx
+	}
 	c.mu.Lock()
 
 	// Find the bean
@@ -760,10 +919,12 @@ func (c *Core) Archive(id string) error {
 		c.mu.Unlock()
 		return fmt.Errorf("moving bean to archive: %w", err)
 	}
+	c.recordMove(OpArchive, targetBean.Path, newRelPath)
 
 	// Update bean's path in store and notify subscribers
 	targetBean.Path = newRelPath
 	c.beans[targetID] = targetBean
+	c.reindexLocked(targetBean)
 	c.mu.Unlock()
 
 	c.fanOut([]BeanEvent{{
@@ -778,6 +939,9 @@ func (c *Core) Archive(id string) error {
 // Unarchive moves a bean from the archive directory back to the main directory.
 // Supports short IDs (without prefix) if a prefix is configured.
 func (c *Core) Unarchive(id string) error {
+	if c.overlay != nil {
+		return ErrNotInTx
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -800,14 +964,26 @@ func (c *Core) Unarchive(id string) error {
 	if err := os.Rename(oldPath, newPath); err != nil {
 		return fmt.Errorf("moving bean from archive: %w", err)
 	}
+	c.recordMove(OpUnarchive, targetBean.Path, newRelPath)
 
 	// Update bean's path
 	targetBean.Path = newRelPath
 	c.beans[targetID] = targetBean
+	c.reindexLocked(targetBean)
 
 	return nil
 }
 
+// reindexLocked updates a bean in the search index, if it is active, after
+// it moved in or out of the archive. Must be called with lock held.
+func (c *Core) reindexLocked(b *bean.Bean) {
+	if c.searchIndex != nil {
+		if err := c.searchIndex.IndexBean(b); err != nil {
+			c.logWarn("failed to index bean %s: %v", b.ID, err)
+		}
+	}
+}
+
 // IsArchived returns true if the bean with the given ID is in the archive.
 // Supports short IDs (without prefix) if a prefix is configured.
 func (c *Core) IsArchived(id string) bool {
@@ -822,6 +998,15 @@ func (c *Core) IsArchived(id string) bool {
 	return c.isArchivedPath(b.Path)
 }
 
+// IsOverdue returns true if the bean's due date is before now and its status
+// is not an archive status (completed, scrapped, or a custom equivalent).
+func (c *Core) IsOverdue(b *bean.Bean, now time.Time) bool {
+	if b.DueAt == nil || !b.DueAt.Before(now) {
+		return false
+	}
+	return !c.Config().IsArchiveStatus(b.Status)
+}
+
 // isArchivedPath returns true if the path indicates an archived bean.
 func (c *Core) isArchivedPath(path string) bool {
 	return strings.HasPrefix(path, ArchiveDir+string(filepath.Separator)) ||
@@ -891,6 +1076,9 @@ func (c *Core) GetFromArchive(id string) (*bean.Bean, error) {
 // LoadAndUnarchive finds a bean in the archive, loads it, unarchives it,
 // and adds it to the in-memory store. Returns the bean or ErrNotFound.
 func (c *Core) LoadAndUnarchive(id string) (*bean.Bean, error) {
+	if c.overlay != nil {
+		return nil, ErrNotInTx
+	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -913,6 +1101,7 @@ func (c *Core) LoadAndUnarchive(id string) (*bean.Bean, error) {
 	if err := os.Rename(oldPath, newPath); err != nil {
 		return nil, fmt.Errorf("moving bean from archive: %w", err)
 	}
+	c.recordMove(OpUnarchive, b.Path, newRelPath)
 
 	// Update bean's path
 	b.Path = newRelPath
@@ -969,7 +1158,7 @@ func Init(dir string) error {
 // to exclude conversation logs from version control.
 // Note: worktrees are stored outside the repo (in ~/.beans/worktrees/<project>/).
 func writeGitignore(beansDir string) error {
-	content := "# Generated by beans init\n.conversations/\n"
+	content := "# Generated by beans init\n.conversations/\n" + JournalFile + "\n"
 	return os.WriteFile(filepath.Join(beansDir, ".gitignore"), []byte(content), 0644)
 }
 
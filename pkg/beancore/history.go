@@ -0,0 +1,111 @@
+package beancore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/gitutil"
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// History actions describe what a commit did to a bean file.
+const (
+	HistoryCreated    = "created"
+	HistoryUpdated    = "updated"
+	HistoryArchived   = "archived"
+	HistoryUnarchived = "unarchived"
+	HistoryMoved      = "moved"
+	HistoryDeleted    = "deleted"
+)
+
+// ErrNoHistory is returned by History when the beans directory is not in a
+// git repository.
+var ErrNoHistory = errors.New("bean history requires a git repository")
+
+// HistoryEntry is one commit in a bean's history.
+type HistoryEntry struct {
+	Commit      string             `json:"commit"`
+	Author      string             `json:"author"`
+	AuthorEmail string             `json:"author_email"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Message     string             `json:"message"`
+	Action      string             `json:"action"`
+	Changes     []bean.FieldChange `json:"changes,omitempty"`
+}
+
+// History returns the committed history of a bean, newest first, by walking
+// git log --follow on its file. Each entry carries the field changes relative
+// to the previous commit. Renames into or out of the archive directory are
+// reported as archive and unarchive actions. Uncommitted changes are not included.
+func (c *Core) History(id string) ([]HistoryEntry, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := gitutil.RepoPrefix(c.root)
+	if err != nil {
+		return nil, ErrNoHistory
+	}
+	revisions, err := gitutil.FileLog(c.root, c.FullPath(b))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]HistoryEntry, len(revisions))
+	var prev *bean.Bean
+	prevPath := ""
+	// Walk oldest to newest so each revision is diffed against its predecessor
+	for i := len(revisions) - 1; i >= 0; i-- {
+		rev := revisions[i]
+		path := strings.TrimPrefix(rev.Path, prefix)
+		entry := HistoryEntry{
+			Commit:      rev.Hash,
+			Author:      rev.Author,
+			AuthorEmail: rev.Email,
+			Timestamp:   rev.Time,
+			Message:     rev.Subject,
+		}
+
+		var current *bean.Bean
+		if rev.Deleted {
+			entry.Action = HistoryDeleted
+		} else {
+			content, err := gitutil.ShowFile(c.root, rev.Hash, rev.Path)
+			if err != nil {
+				return nil, err
+			}
+			current, err = bean.Parse(bytes.NewReader(content))
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s at %s: %w", rev.Path, rev.Hash[:7], err)
+			}
+			entry.Action = historyAction(prev == nil, c.isArchivedPath(prevPath), c.isArchivedPath(path), prevPath != path)
+			entry.Changes = bean.Diff(prev, current)
+		}
+
+		entries[i] = entry
+		prev = current
+		prevPath = path
+	}
+
+	return entries, nil
+}
+
+// historyAction classifies a revision of a bean file.
+func historyAction(first, wasArchived, isArchived, moved bool) string {
+	switch {
+	case first:
+		return HistoryCreated
+	case !wasArchived && isArchived:
+		return HistoryArchived
+	case wasArchived && !isArchived:
+		return HistoryUnarchived
+	case moved:
+		return HistoryMoved
+	default:
+		return HistoryUpdated
+	}
+}
@@ -0,0 +1,98 @@
+package beancore
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// gitCommitAll commits everything in the repository containing dir.
+func gitCommitAll(t *testing.T, dir, message string) {
+	t.Helper()
+	for _, args := range [][]string{
+		{"add", "-A"},
+		{"-c", "user.name=Alice", "-c", "user.email=alice@example.com", "commit", "-q", "-m", message},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %s\n%s", args, err, out)
+		}
+	}
+}
+
+func TestHistory(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	repo := filepath.Dir(beansDir)
+	if out, err := exec.Command("git", "-C", repo, "init", "-q").CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %s\n%s", err, out)
+	}
+
+	b := createTestBean(t, core, "hist-1", "Login", "todo")
+	gitCommitAll(t, repo, "Add login bean")
+
+	b.Status = "in-progress"
+	b.Tags = []string{"auth"}
+	b.Body = "First line"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	gitCommitAll(t, repo, "Start login")
+
+	b.Status = "completed"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := core.Archive(b.ID); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	gitCommitAll(t, repo, "Finish login")
+
+	entries, err := core.History("hist-1")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("History() = %d entries, want 3", len(entries))
+	}
+
+	wantActions := []string{HistoryArchived, HistoryUpdated, HistoryCreated}
+	for i, want := range wantActions {
+		if entries[i].Action != want {
+			t.Errorf("entries[%d].Action = %q, want %q", i, entries[i].Action, want)
+		}
+		if entries[i].Author != "Alice" || entries[i].AuthorEmail != "alice@example.com" {
+			t.Errorf("entries[%d] author = %s <%s>", i, entries[i].Author, entries[i].AuthorEmail)
+		}
+	}
+
+	changes := map[string]bool{}
+	for _, c := range entries[1].Changes {
+		changes[c.Field] = true
+		if c.Field == "status" && (c.From != "todo" || c.To != "in-progress") {
+			t.Errorf("status change = %s → %s, want todo → in-progress", c.From, c.To)
+		}
+		if c.Field == "tags" && (len(c.Added) != 1 || c.Added[0] != "auth") {
+			t.Errorf("tags change added = %v, want [auth]", c.Added)
+		}
+	}
+	for _, field := range []string{"status", "tags", "body"} {
+		if !changes[field] {
+			t.Errorf("update entry missing %s change: %+v", field, entries[1].Changes)
+		}
+	}
+
+	if entries[0].Message != "Finish login" || len(entries[0].Changes) != 1 || entries[0].Changes[0].Field != "status" {
+		t.Errorf("archive entry = %+v, want status change in \"Finish login\"", entries[0])
+	}
+}
+
+func TestHistoryWithoutGit(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "hist-2", "Untracked", "todo")
+
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(filepath.Dir(core.Root())))
+	if _, err := core.History("hist-2"); !errors.Is(err, ErrNoHistory) {
+		t.Errorf("History() error = %v, want ErrNoHistory", err)
+	}
+}
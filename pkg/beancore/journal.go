@@ -0,0 +1,365 @@
+package beancore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// JournalFile is the name of the operation journal inside the beans directory.
+const JournalFile = ".journal.json"
+
+// journalLimit is the number of operations kept in the journal. Older
+// operations can no longer be undone.
+const journalLimit = 100
+
+// Journal operation names.
+const (
+	OpCreate    = "create"
+	OpUpdate    = "update"
+	OpDelete    = "delete"
+	OpArchive   = "archive"
+	OpUnarchive = "unarchive"
+	OpMerge     = "merge"
+	OpBatch     = "batch"
+)
+
+var (
+	// ErrNothingToUndo is returned by Undo when the journal has no operation left to revert.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrNothingToRedo is returned by Redo when no undone operation is left to re-apply.
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
+
+// JournalConflictError is returned when undoing or redoing an operation
+// whose files were changed since, e.g. by a later edit that is not in the
+// journal. Nothing is written in that case.
+type JournalConflictError struct {
+	Path string
+}
+
+func (e *JournalConflictError) Error() string {
+	return fmt.Sprintf("%s changed since this operation", e.Path)
+}
+
+// FileChange is the content of one bean file before and after an operation,
+// as rendered by Bean.Render. Path is relative to the beans directory. An
+// empty Before means the operation created the file; an empty After means it
+// removed it.
+type FileChange struct {
+	Path   string `json:"path"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// JournalEntry is one journaled operation.
+type JournalEntry struct {
+	Seq     int          `json:"seq"`
+	Time    time.Time    `json:"time"`
+	Op      string       `json:"op"`
+	BeanIDs []string     `json:"bean_ids"`
+	Changes []FileChange `json:"changes"`
+}
+
+// Journal is the local record of recent operations. Entries are oldest
+// first; the first Position entries are applied and the rest have been
+// undone and can be redone.
+type Journal struct {
+	Entries  []JournalEntry `json:"entries"`
+	Position int            `json:"position"`
+}
+
+// Journal returns the operation journal.
+func (c *Core) Journal() (*Journal, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.readJournal()
+}
+
+// Undo reverts the last n applied operations, newest first, and returns
+// them. Fails with ErrNothingToUndo if there is none, and with a
+// JournalConflictError if a file was changed since an operation, in which
+// case the operations before it are still reverted.
+func (c *Core) Undo(n int) ([]JournalEntry, error) {
+	return c.replayJournal(n, true)
+}
+
+// Redo re-applies the last n undone operations, oldest first, and returns
+// them. Any new operation after an undo discards the undone operations.
+func (c *Core) Redo(n int) ([]JournalEntry, error) {
+	return c.replayJournal(n, false)
+}
+
+func (c *Core) replayJournal(n int, undo bool) ([]JournalEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	j, err := c.readJournal()
+	if err != nil {
+		return nil, err
+	}
+	if undo && j.Position == 0 {
+		return nil, ErrNothingToUndo
+	}
+	if !undo && j.Position == len(j.Entries) {
+		return nil, ErrNothingToRedo
+	}
+
+	var replayed []JournalEntry
+	for range n {
+		var entry JournalEntry
+		if undo {
+			if j.Position == 0 {
+				break
+			}
+			entry = j.Entries[j.Position-1]
+		} else {
+			if j.Position == len(j.Entries) {
+				break
+			}
+			entry = j.Entries[j.Position]
+		}
+
+		if err = c.applyChangesLocked(entry.Changes, undo); err != nil {
+			break
+		}
+		if undo {
+			j.Position--
+		} else {
+			j.Position++
+		}
+		replayed = append(replayed, entry)
+	}
+
+	if len(replayed) > 0 {
+		if writeErr := c.writeJournal(j); writeErr != nil && err == nil {
+			err = writeErr
+		}
+	}
+	return replayed, err
+}
+
+// applyChangesLocked writes the After content of changes, or the Before
+// content if reverse is set, after checking that every file still has the
+// content the operation left (or found) there. Updates the in-memory beans
+// to match.
+func (c *Core) applyChangesLocked(changes []FileChange, reverse bool) error {
+	changes = slices.Clone(changes)
+	if reverse {
+		slices.Reverse(changes)
+	}
+
+	for _, ch := range changes {
+		current, err := os.ReadFile(filepath.Join(c.root, ch.Path))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if string(current) != target(ch, !reverse) {
+			return &JournalConflictError{Path: ch.Path}
+		}
+	}
+
+	// Removals first, so a bean moved between directories ends up loaded
+	// from its new path
+	slices.SortStableFunc(changes, func(a, b FileChange) int {
+		if (target(a, reverse) == "") == (target(b, reverse) == "") {
+			return 0
+		}
+		if target(a, reverse) == "" {
+			return -1
+		}
+		return 1
+	})
+	for _, ch := range changes {
+		path := filepath.Join(c.root, ch.Path)
+		id, _ := bean.ParseFilename(filepath.Base(ch.Path))
+		content := target(ch, reverse)
+
+		if content == "" {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if b, ok := c.beans[id]; ok && b.Path == ch.Path {
+				delete(c.beans, id)
+				delete(c.dirty, id)
+				if c.searchIndex != nil {
+					if err := c.searchIndex.DeleteBean(id); err != nil {
+						c.logWarn("failed to remove bean %s from search index: %v", id, err)
+					}
+				}
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("creating directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+		b, err := c.loadBean(path)
+		if err != nil {
+			return fmt.Errorf("loading %s: %w", ch.Path, err)
+		}
+		c.beans[b.ID] = b
+		delete(c.dirty, b.ID)
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(b); err != nil {
+				c.logWarn("failed to update bean %s in search index: %v", b.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// target returns the content ch leaves behind when applied, or when
+// reverted if reverse is set.
+func target(ch FileChange, reverse bool) string {
+	if reverse {
+		return ch.Before
+	}
+	return ch.After
+}
+
+// beginJournalGroup collects the changes of the following writes into one
+// journal entry, recorded by endJournalGroup. Must be called with the lock
+// held.
+func (c *Core) beginJournalGroup() {
+	c.journalGroup = &[]FileChange{}
+}
+
+// endJournalGroup records the changes collected since beginJournalGroup as
+// one operation.
+func (c *Core) endJournalGroup(op string) {
+	if c.journalGroup == nil {
+		return
+	}
+	changes := *c.journalGroup
+	c.journalGroup = nil
+	c.record(op, changes...)
+}
+
+// record appends an operation to the journal, discarding any undone
+// operations. If op is empty, it is derived from the changes. Best-effort:
+// failures are logged, not returned. Must be called with the lock held.
+func (c *Core) record(op string, changes ...FileChange) {
+	if c.overlay != nil || len(changes) == 0 {
+		return
+	}
+	if c.journalGroup != nil {
+		*c.journalGroup = append(*c.journalGroup, changes...)
+		return
+	}
+
+	if op == "" {
+		op = inferOp(changes)
+	}
+
+	j, err := c.readJournal()
+	if err != nil {
+		c.logWarn("failed to read journal: %v", err)
+		return
+	}
+
+	entry := JournalEntry{
+		Seq:     1,
+		Time:    time.Now().UTC().Truncate(time.Second),
+		Op:      op,
+		Changes: changes,
+	}
+	if len(j.Entries) > 0 {
+		entry.Seq = j.Entries[len(j.Entries)-1].Seq + 1
+	}
+	for _, ch := range changes {
+		id, _ := bean.ParseFilename(filepath.Base(ch.Path))
+		if !slices.Contains(entry.BeanIDs, id) {
+			entry.BeanIDs = append(entry.BeanIDs, id)
+		}
+	}
+
+	j.Entries = append(j.Entries[:j.Position], entry)
+	if len(j.Entries) > journalLimit {
+		j.Entries = j.Entries[len(j.Entries)-journalLimit:]
+	}
+	j.Position = len(j.Entries)
+
+	if err := c.writeJournal(j); err != nil {
+		c.logWarn("failed to write journal: %v", err)
+	}
+}
+
+// inferOp names an operation from its changes: a single create, update or
+// delete, a delete that also removed links from other beans, or a batch.
+func inferOp(changes []FileChange) string {
+	var created, deleted int
+	for _, ch := range changes {
+		switch {
+		case ch.Before == "":
+			created++
+		case ch.After == "":
+			deleted++
+		}
+	}
+	switch {
+	case len(changes) == 1 && created == 1:
+		return OpCreate
+	case deleted == 1 && created == 0:
+		return OpDelete
+	case len(changes) == 1:
+		return OpUpdate
+	default:
+		return OpBatch
+	}
+}
+
+// recordMove journals a bean file moved from oldRelPath to newRelPath.
+func (c *Core) recordMove(op, oldRelPath, newRelPath string) {
+	content, err := os.ReadFile(filepath.Join(c.root, newRelPath))
+	if err != nil {
+		c.logWarn("failed to journal %s: %v", op, err)
+		return
+	}
+	c.record(op,
+		FileChange{Path: oldRelPath, Before: string(content)},
+		FileChange{Path: newRelPath, After: string(content)},
+	)
+}
+
+// readJournal reads the journal file, returning an empty journal if there is none.
+func (c *Core) readJournal() (*Journal, error) {
+	j := &Journal{}
+	data, err := os.ReadFile(filepath.Join(c.root, JournalFile))
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, j); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", JournalFile, err)
+	}
+	j.Position = min(max(j.Position, 0), len(j.Entries))
+	return j, nil
+}
+
+// writeJournal replaces the journal file via a temporary file, so a reader
+// never sees a partial journal.
+func (c *Core) writeJournal(j *Journal) error {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.root, JournalFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
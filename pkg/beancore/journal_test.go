@@ -0,0 +1,154 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestUndoRedo(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	b := &bean.Bean{ID: "undo1", Title: "Original", Status: "todo", Body: "Keep this paragraph."}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	b.Body = "Oops, replaced."
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := core.Delete("undo1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	j, err := core.Journal()
+	if err != nil {
+		t.Fatalf("Journal() error = %v", err)
+	}
+	var ops []string
+	for _, e := range j.Entries {
+		ops = append(ops, e.Op)
+	}
+	if len(ops) != 3 || ops[0] != OpCreate || ops[1] != OpUpdate || ops[2] != OpDelete {
+		t.Fatalf("journal ops = %v, want [create update delete]", ops)
+	}
+
+	// Undo the delete and the body edit
+	undone, err := core.Undo(2)
+	if err != nil {
+		t.Fatalf("Undo(2) error = %v", err)
+	}
+	if len(undone) != 2 || undone[0].Op != OpDelete || undone[1].Op != OpUpdate {
+		t.Errorf("Undo(2) = %v, want delete then update", undone)
+	}
+	restored, err := core.Get("undo1")
+	if err != nil {
+		t.Fatalf("Get() after undo error = %v", err)
+	}
+	if strings.TrimSpace(restored.Body) != "Keep this paragraph." {
+		t.Errorf("Body after undo = %q, want original", restored.Body)
+	}
+
+	// Redo the body edit only
+	if _, err := core.Redo(1); err != nil {
+		t.Fatalf("Redo(1) error = %v", err)
+	}
+	if got, _ := core.Get("undo1"); strings.TrimSpace(got.Body) != "Oops, replaced." {
+		t.Errorf("Body after redo = %q, want the edit re-applied", got.Body)
+	}
+
+	// A new operation discards the remaining redo
+	got, _ := core.Get("undo1")
+	got.Title = "Renamed"
+	if err := core.Update(got, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if _, err := core.Redo(1); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Redo() error = %v, want ErrNothingToRedo", err)
+	}
+
+	// Undoing everything removes the bean again
+	if _, err := core.Undo(10); err != nil {
+		t.Fatalf("Undo(10) error = %v", err)
+	}
+	if _, err := core.Get("undo1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after undoing create error = %v, want ErrNotFound", err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(beansDir, "*.md")); len(files) != 0 {
+		t.Errorf("bean files after undoing everything = %v, want none", files)
+	}
+	if _, err := core.Undo(1); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("Undo() error = %v, want ErrNothingToUndo", err)
+	}
+}
+
+func TestUndoGroupsAndMoves(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	for _, b := range []*bean.Bean{
+		{ID: "keep", Title: "Keep", Status: "todo"},
+		{ID: "dupe", Title: "Dupe", Status: "todo", Tags: []string{"auth"}},
+		{ID: "other", Title: "Other", Status: "todo", Blocking: []string{"dupe"}},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	if _, err := core.Merge("dupe", "keep"); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	undone, err := core.Undo(1)
+	if err != nil {
+		t.Fatalf("Undo() error = %v", err)
+	}
+	if undone[0].Op != OpMerge || len(undone[0].Changes) != 3 {
+		t.Errorf("undone = %s with %d changes, want merge with 3", undone[0].Op, len(undone[0].Changes))
+	}
+	dupe, _ := core.Get("dupe")
+	other, _ := core.Get("other")
+	if dupe.Status != "todo" || !other.IsBlocking("dupe") {
+		t.Errorf("merge not fully undone: dupe.Status = %q, other.Blocking = %v", dupe.Status, other.Blocking)
+	}
+
+	if err := core.Archive("keep"); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	if _, err := core.Undo(1); err != nil {
+		t.Fatalf("Undo() archive error = %v", err)
+	}
+	if core.IsArchived("keep") {
+		t.Error("keep still archived after undo")
+	}
+}
+
+func TestUndoConflict(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	b := &bean.Bean{ID: "conflict", Title: "Before", Status: "todo"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	b.Title = "After"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// An edit made outside beans is not in the journal
+	path := filepath.Join(beansDir, b.Path)
+	if err := os.WriteFile(path, []byte("---\ntitle: Hand edited\nstatus: todo\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conflict *JournalConflictError
+	if _, err := core.Undo(1); !errors.As(err, &conflict) || conflict.Path != b.Path {
+		t.Fatalf("Undo() error = %v, want JournalConflictError for %s", err, b.Path)
+	}
+	if content, _ := os.ReadFile(path); string(content) != "---\ntitle: Hand edited\nstatus: todo\n---\n" {
+		t.Errorf("file changed by a conflicting undo:\n%s", content)
+	}
+}
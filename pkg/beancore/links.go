@@ -2,6 +2,7 @@ package beancore
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hmans/beans/pkg/bean"
@@ -32,21 +33,31 @@ type Cycle struct {
 	Path     []string `json:"path"`
 }
 
+// InvalidParent represents a parent link that violates the configured
+// parent type rules.
+type InvalidParent struct {
+	BeanID     string `json:"bean_id"`
+	BeanType   string `json:"bean_type"`
+	Parent     string `json:"parent"`
+	ParentType string `json:"parent_type"`
+}
+
 // LinkCheckResult contains all link validation issues found.
 type LinkCheckResult struct {
-	BrokenLinks []BrokenLink `json:"broken_links"`
-	SelfLinks   []SelfLink   `json:"self_links"`
-	Cycles      []Cycle      `json:"cycles"`
+	BrokenLinks    []BrokenLink    `json:"broken_links"`
+	SelfLinks      []SelfLink      `json:"self_links"`
+	Cycles         []Cycle         `json:"cycles"`
+	InvalidParents []InvalidParent `json:"invalid_parents"`
 }
 
 // HasIssues returns true if any link issues were found.
 func (r *LinkCheckResult) HasIssues() bool {
-	return len(r.BrokenLinks) > 0 || len(r.SelfLinks) > 0 || len(r.Cycles) > 0
+	return r.TotalIssues() > 0
 }
 
 // TotalIssues returns the total count of all issues.
 func (r *LinkCheckResult) TotalIssues() int {
-	return len(r.BrokenLinks) + len(r.SelfLinks) + len(r.Cycles)
+	return len(r.BrokenLinks) + len(r.SelfLinks) + len(r.Cycles) + len(r.InvalidParents)
 }
 
 // FindIncomingLinks returns all beans that link TO the given bean ID.
@@ -81,22 +92,142 @@ func (c *Core) FindIncomingLinks(targetID string) []IncomingLink {
 				})
 			}
 		}
+		// Check typed links
+		for _, linkType := range b.LinkTypes() {
+			if b.HasLink(linkType, targetID) {
+				result = append(result, IncomingLink{
+					FromBean: b,
+					LinkType: linkType,
+				})
+			}
+		}
 	}
 	return result
 }
 
+// FindLinkedBeans returns the beans that the given bean links to with a typed
+// link. For symmetric link types, beans linking to it are included as well.
+// Broken links are skipped.
+func (c *Core) FindLinkedBeans(beanID, linkType string) []*bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.linkedBeansLocked(beanID, linkType, false)
+}
+
+// FindLinkingBeans returns the beans that link to the given bean with a typed
+// link, i.e. the inverse relationship. For symmetric link types, the beans it
+// links to are included as well.
+func (c *Core) FindLinkingBeans(beanID, linkType string) []*bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.linkedBeansLocked(beanID, linkType, true)
+}
+
+// linkedBeansLocked collects the beans on one side of a typed link, or on
+// both sides for symmetric link types. Must be called with c.mu held.
+func (c *Core) linkedBeansLocked(beanID, linkType string, incoming bool) []*bean.Bean {
+	symmetric := false
+	if lt := c.config.GetLinkType(linkType); lt != nil {
+		symmetric = lt.Symmetric
+	}
+
+	seen := make(map[string]bool)
+	var result []*bean.Bean
+	add := func(b *bean.Bean) {
+		if !seen[b.ID] && b.ID != beanID {
+			seen[b.ID] = true
+			result = append(result, b)
+		}
+	}
+
+	if !incoming || symmetric {
+		if b, ok := c.beans[beanID]; ok {
+			for _, targetID := range b.Links[linkType] {
+				if target, ok := c.beans[targetID]; ok {
+					add(target)
+				}
+			}
+		}
+	}
+	if incoming || symmetric {
+		for _, other := range c.beans {
+			if other.HasLink(linkType, beanID) {
+				add(other)
+			}
+		}
+	}
+	return result
+}
+
+// ValidateLink checks that a typed link from b to targetID may be added: the
+// link type must be declared, the target must exist and differ from b, and
+// acyclic link types must not form a cycle.
+func (c *Core) ValidateLink(b *bean.Bean, linkType, targetID string) error {
+	if err := c.config.ValidateLinkType(linkType); err != nil {
+		return err
+	}
+	if targetID == b.ID {
+		return fmt.Errorf("bean cannot link to itself")
+	}
+	if _, err := c.Get(targetID); err != nil {
+		return fmt.Errorf("link target bean not found: %s", targetID)
+	}
+	if cycle := c.DetectCycle(b.ID, linkType, targetID); cycle != nil {
+		return fmt.Errorf("adding %s link would create cycle: %v", linkType, cycle)
+	}
+	return nil
+}
+
+// linkTargets returns the IDs a bean links to with the given link type: one
+// of the built-in parent, blocking and blocked_by links, or a typed link.
+func linkTargets(b *bean.Bean, linkType string) []string {
+	switch linkType {
+	case "parent":
+		if b.Parent != "" {
+			return []string{b.Parent}
+		}
+		return nil
+	case "blocking":
+		return b.Blocking
+	case "blocked_by":
+		return b.BlockedBy
+	default:
+		return b.Links[linkType]
+	}
+}
+
+// acyclicLinkTypes returns the link types that are checked for cycles: the
+// built-in hierarchical links plus typed links configured as acyclic.
+func (c *Core) acyclicLinkTypes() []string {
+	types := []string{"blocking", "blocked_by", "parent"}
+	for _, lt := range c.config.LinkTypes() {
+		if lt.Acyclic {
+			types = append(types, lt.Name)
+		}
+	}
+	return types
+}
+
 // DetectCycle checks if adding a link from fromID to toID would create a cycle.
-// Checks for blocking, blocked_by, and parent link types.
+// Checks for blocking, blocked_by, parent, and typed links configured as acyclic.
 // Returns the cycle path if a cycle would be created, nil otherwise.
 func (c *Core) DetectCycle(fromID, linkType, toID string) []string {
 	// Only check hierarchical link types
-	if linkType != "blocking" && linkType != "blocked_by" && linkType != "parent" {
+	if !slices.Contains(c.acyclicLinkTypes(), linkType) {
 		return nil
 	}
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	return c.detectCycleLocked(fromID, linkType, toID)
+}
+
+// detectCycleLocked is DetectCycle for callers already holding the lock.
+// The link type must be one of acyclicLinkTypes.
+func (c *Core) detectCycleLocked(fromID, linkType, toID string) []string {
 	// Build adjacency list for the specific link type
 	// Adding edge: fromID -> toID
 	// Check if there's already a path from toID back to fromID
@@ -123,20 +254,7 @@ func (c *Core) findPathToTarget(current, target, linkType string, visited map[st
 		return nil
 	}
 
-	// Get targets based on link type
-	var targets []string
-	switch linkType {
-	case "parent":
-		if b.Parent != "" {
-			targets = []string{b.Parent}
-		}
-	case "blocking":
-		targets = b.Blocking
-	case "blocked_by":
-		targets = b.BlockedBy
-	}
-
-	for _, t := range targets {
+	for _, t := range linkTargets(b, linkType) {
 		newPath := append(path, t)
 		if result := c.findPathToTarget(t, target, linkType, visited, newPath); result != nil {
 			return result
@@ -146,15 +264,17 @@ func (c *Core) findPathToTarget(current, target, linkType string, visited map[st
 	return nil
 }
 
-// CheckAllLinks validates all links across all beans.
+// CheckAllLinks validates all links across all beans. Parent links between
+// typed beans are also checked against the configured parent type rules.
 func (c *Core) CheckAllLinks() *LinkCheckResult {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	result := &LinkCheckResult{
-		BrokenLinks: []BrokenLink{},
-		SelfLinks:   []SelfLink{},
-		Cycles:      []Cycle{},
+		BrokenLinks:    []BrokenLink{},
+		SelfLinks:      []SelfLink{},
+		Cycles:         []Cycle{},
+		InvalidParents: []InvalidParent{},
 	}
 
 	// Check for broken links and self-references
@@ -166,12 +286,19 @@ func (c *Core) CheckAllLinks() *LinkCheckResult {
 					BeanID:   b.ID,
 					LinkType: "parent",
 				})
-			} else if _, ok := c.beans[b.Parent]; !ok {
+			} else if parent, ok := c.beans[b.Parent]; !ok {
 				result.BrokenLinks = append(result.BrokenLinks, BrokenLink{
 					BeanID:   b.ID,
 					LinkType: "parent",
 					Target:   b.Parent,
 				})
+			} else if b.Type != "" && parent.Type != "" && !slices.Contains(c.ValidParentTypes(b.Type), parent.Type) {
+				result.InvalidParents = append(result.InvalidParents, InvalidParent{
+					BeanID:     b.ID,
+					BeanType:   b.Type,
+					Parent:     parent.ID,
+					ParentType: parent.Type,
+				})
 			}
 		}
 
@@ -206,10 +333,28 @@ func (c *Core) CheckAllLinks() *LinkCheckResult {
 				})
 			}
 		}
+
+		// Check typed links
+		for _, linkType := range b.LinkTypes() {
+			for _, target := range b.Links[linkType] {
+				if target == b.ID {
+					result.SelfLinks = append(result.SelfLinks, SelfLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+					})
+				} else if _, ok := c.beans[target]; !ok {
+					result.BrokenLinks = append(result.BrokenLinks, BrokenLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+						Target:   target,
+					})
+				}
+			}
+		}
 	}
 
-	// Check for cycles in blocking, blocked_by, and parent links
-	for _, linkType := range []string{"blocking", "blocked_by", "parent"} {
+	// Check for cycles in blocking, blocked_by, parent, and acyclic typed links
+	for _, linkType := range c.acyclicLinkTypes() {
 		cycles := c.findCycles(linkType)
 		result.Cycles = append(result.Cycles, cycles...)
 	}
@@ -259,20 +404,7 @@ func (c *Core) findCycles(linkType string) []Cycle {
 
 		b, ok := c.beans[id]
 		if ok {
-			// Get targets based on link type
-			var targets []string
-			switch linkType {
-			case "parent":
-				if b.Parent != "" {
-					targets = []string{b.Parent}
-				}
-			case "blocking":
-				targets = b.Blocking
-			case "blocked_by":
-				targets = b.BlockedBy
-			}
-
-			for _, target := range targets {
+			for _, target := range linkTargets(b, linkType) {
 				// Skip self-references (they're tracked separately as SelfLinks)
 				if target == id {
 					continue
@@ -330,6 +462,9 @@ func (c *Core) RemoveLinksTo(targetID string) (int, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.beginJournalGroup()
+	defer c.endJournalGroup("")
+
 	removed := 0
 	for _, b := range c.beans {
 		changed := false
@@ -357,6 +492,15 @@ func (c *Core) RemoveLinksTo(targetID string) (int, error) {
 			removed += originalBlockedByLen - len(b.BlockedBy)
 		}
 
+		// Remove typed links
+		for _, linkType := range b.LinkTypes() {
+			if b.HasLink(linkType, targetID) {
+				b.RemoveLink(linkType, targetID)
+				changed = true
+				removed++
+			}
+		}
+
 		if changed {
 			if err := c.saveToDisk(b); err != nil {
 				return removed, err
@@ -431,6 +575,17 @@ func (c *Core) FixBrokenLinks() (int, error) {
 			fixed += originalBlockedByLen - len(newBlockedBy)
 		}
 
+		// Fix typed links
+		for _, linkType := range b.LinkTypes() {
+			for _, target := range b.Links[linkType] {
+				if _, ok := c.beans[target]; target == b.ID || !ok {
+					b.RemoveLink(linkType, target)
+					changed = true
+					fixed++
+				}
+			}
+		}
+
 		if changed {
 			if err := c.saveToDisk(b); err != nil {
 				return fixed, err
@@ -441,21 +596,11 @@ func (c *Core) FixBrokenLinks() (int, error) {
 	return fixed, nil
 }
 
-// ValidParentTypes returns the valid parent types for a given bean type.
+// ValidParentTypes returns the valid parent types for a given bean type,
+// as configured in the project's type definitions.
 // Returns nil if the bean type cannot have a parent.
-func ValidParentTypes(beanType string) []string {
-	switch beanType {
-	case "milestone":
-		return nil // milestones cannot have parents
-	case "epic":
-		return []string{"milestone"}
-	case "feature":
-		return []string{"milestone", "epic"}
-	case "task", "bug":
-		return []string{"milestone", "epic", "feature"}
-	default:
-		return []string{"milestone", "epic", "feature"} // default for unknown types
-	}
+func (c *Core) ValidParentTypes(beanType string) []string {
+	return c.config.ValidParentTypes(beanType)
 }
 
 // ValidateParent checks if a parent is valid for the given bean.
@@ -465,7 +610,7 @@ func (c *Core) ValidateParent(b *bean.Bean, parentID string) error {
 		return nil
 	}
 
-	validTypes := ValidParentTypes(b.Type)
+	validTypes := c.ValidParentTypes(b.Type)
 	if validTypes == nil {
 		return fmt.Errorf("%s beans cannot have a parent", b.Type)
 	}
@@ -499,10 +644,11 @@ func joinWithOr(items []string) string {
 	}
 }
 
-// isResolvedStatus returns true if the status means the bean is "done"
-// (either completed or scrapped).
-func isResolvedStatus(status string) bool {
-	return status == "completed" || status == "scrapped"
+// isResolvedStatus returns true if the status means the bean is "done",
+// i.e. it is one of the configured archive statuses (completed and scrapped
+// by default).
+func (c *Core) isResolvedStatus(status string) bool {
+	return c.config.IsArchiveStatus(status)
 }
 
 // IsBlocked returns true if the bean is blocked, either explicitly (direct
@@ -581,7 +727,7 @@ func (c *Core) findActiveBlockersLocked(beanID string, seen map[string]bool) []*
 	// Check direct blocked_by field
 	for _, blockerID := range b.BlockedBy {
 		if blocker, ok := c.beans[blockerID]; ok {
-			if !isResolvedStatus(blocker.Status) && !seen[blockerID] {
+			if !c.isResolvedStatus(blocker.Status) && !seen[blockerID] {
 				seen[blockerID] = true
 				blockers = append(blockers, blocker)
 			}
@@ -591,7 +737,7 @@ func (c *Core) findActiveBlockersLocked(beanID string, seen map[string]bool) []*
 	// Check incoming blocking links (other beans that have this bean in their Blocking list)
 	for _, other := range c.beans {
 		for _, blocked := range other.Blocking {
-			if blocked == beanID && !isResolvedStatus(other.Status) && !seen[other.ID] {
+			if blocked == beanID && !c.isResolvedStatus(other.Status) && !seen[other.ID] {
 				seen[other.ID] = true
 				blockers = append(blockers, other)
 			}
@@ -643,7 +789,7 @@ func (c *Core) implicitStatusLocked(beanID string) (status, fromID string) {
 
 	var result struct{ status, fromID string }
 	c.walkParentChain(b.Parent, func(ancestor *bean.Bean) {
-		if result.status == "" && isResolvedStatus(ancestor.Status) {
+		if result.status == "" && c.isResolvedStatus(ancestor.Status) {
 			result.status = ancestor.Status
 			result.fromID = ancestor.ID
 		}
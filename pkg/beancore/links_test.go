@@ -1,9 +1,11 @@
 package beancore
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
 )
 
 func TestFindIncomingLinks(t *testing.T) {
@@ -276,6 +278,83 @@ func TestCheckAllLinksClean(t *testing.T) {
 	}
 }
 
+func TestValidateParentCustomTypes(t *testing.T) {
+	core, _ := setupTestCore(t)
+	none := []string{}
+	choreParents := []string{"epic"}
+	core.Config().CustomTypes = []config.CustomTypeConfig{
+		{Name: "spike"},
+		{Name: "incident", Parents: &none},
+		{Name: "chore", Parents: &choreParents},
+	}
+
+	for _, b := range []*bean.Bean{
+		{ID: "mile", Title: "Milestone", Status: "todo", Type: "milestone"},
+		{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"},
+		{ID: "feat", Title: "Feature", Status: "todo", Type: "feature"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		beanType string
+		parentID string
+		wantErr  string
+	}{
+		{"spike", "feat", ""},
+		{"chore", "epic", ""},
+		{"chore", "mile", "chore beans can only have epic as parent, not milestone"},
+		{"incident", "epic", "incident beans cannot have a parent"},
+		{"epic", "feat", "epic beans can only have milestone as parent, not feature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.beanType+"->"+tt.parentID, func(t *testing.T) {
+			err := core.ValidateParent(&bean.Bean{ID: "child", Type: tt.beanType}, tt.parentID)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateParent() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateParent() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckAllLinksInvalidParents(t *testing.T) {
+	core, _ := setupTestCore(t)
+	choreParents := []string{"epic"}
+	core.Config().CustomTypes = []config.CustomTypeConfig{{Name: "chore", Parents: &choreParents}}
+
+	for _, b := range []*bean.Bean{
+		{ID: "mile", Title: "Milestone", Status: "todo", Type: "milestone"},
+		{ID: "epic", Title: "Epic", Status: "todo", Type: "epic", Parent: "mile"},
+		{ID: "ok", Title: "Chore", Status: "todo", Type: "chore", Parent: "epic"},
+		{ID: "bad", Title: "Chore", Status: "todo", Type: "chore", Parent: "mile"},
+		{ID: "untyped", Title: "Untyped", Status: "todo", Parent: "mile"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	result := core.CheckAllLinks()
+	if len(result.InvalidParents) != 1 {
+		t.Fatalf("InvalidParents = %+v, want 1 entry", result.InvalidParents)
+	}
+	want := InvalidParent{BeanID: "bad", BeanType: "chore", Parent: "mile", ParentType: "milestone"}
+	if result.InvalidParents[0] != want {
+		t.Errorf("InvalidParents[0] = %+v, want %+v", result.InvalidParents[0], want)
+	}
+	if result.TotalIssues() != 1 {
+		t.Errorf("TotalIssues() = %d, want 1", result.TotalIssues())
+	}
+}
+
 func TestRemoveLinksTo(t *testing.T) {
 	core, _ := setupTestCore(t)
 
@@ -373,6 +452,92 @@ func TestFixBrokenLinks(t *testing.T) {
 	}
 }
 
+func TestTypedLinks(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	beanA := &bean.Bean{ID: "aaa1", Title: "Bean A", Status: "todo", Links: map[string][]string{
+		"duplicates": {"bbb2"},
+		"relates_to": {"ccc3", "aaa1", "gone"},
+	}}
+	beanB := &bean.Bean{ID: "bbb2", Title: "Bean B", Status: "todo", Links: map[string][]string{
+		"duplicates": {"aaa1"},
+	}}
+	beanC := &bean.Bean{ID: "ccc3", Title: "Bean C", Status: "todo"}
+	for _, b := range []*bean.Bean{beanA, beanB, beanC} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create error: %v", err)
+		}
+	}
+
+	t.Run("linked beans", func(t *testing.T) {
+		// relates_to is symmetric: C sees A even though only A links to C
+		if got := core.FindLinkedBeans("ccc3", "relates_to"); len(got) != 1 || got[0].ID != "aaa1" {
+			t.Errorf("FindLinkedBeans(ccc3, relates_to) = %v, want [aaa1]", got)
+		}
+		if got := core.FindLinkingBeans("aaa1", "duplicates"); len(got) != 1 || got[0].ID != "bbb2" {
+			t.Errorf("FindLinkingBeans(aaa1, duplicates) = %v, want [bbb2]", got)
+		}
+		incoming := core.FindIncomingLinks("ccc3")
+		if len(incoming) != 1 || incoming[0].LinkType != "relates_to" {
+			t.Errorf("FindIncomingLinks(ccc3) = %v, want one relates_to link", incoming)
+		}
+	})
+
+	t.Run("check", func(t *testing.T) {
+		result := core.CheckAllLinks()
+		if len(result.BrokenLinks) != 1 || result.BrokenLinks[0].LinkType != "relates_to" || result.BrokenLinks[0].Target != "gone" {
+			t.Errorf("BrokenLinks = %v, want relates_to:gone", result.BrokenLinks)
+		}
+		if len(result.SelfLinks) != 1 || result.SelfLinks[0].LinkType != "relates_to" {
+			t.Errorf("SelfLinks = %v, want relates_to self-link", result.SelfLinks)
+		}
+		// duplicates is acyclic, relates_to is not
+		if len(result.Cycles) != 1 || result.Cycles[0].LinkType != "duplicates" {
+			t.Errorf("Cycles = %v, want one duplicates cycle", result.Cycles)
+		}
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		if err := core.ValidateLink(beanC, "caused_by", "aaa1"); err != nil {
+			t.Errorf("ValidateLink(caused_by) error = %v", err)
+		}
+		if err := core.ValidateLink(beanC, "follows", "aaa1"); err == nil || !strings.Contains(err.Error(), "unknown link type") {
+			t.Errorf("ValidateLink(follows) error = %v, want unknown link type", err)
+		}
+		if err := core.ValidateLink(beanC, "relates_to", "ccc3"); err == nil {
+			t.Error("ValidateLink() to itself should fail")
+		}
+		if err := core.ValidateLink(beanC, "relates_to", "nope"); err == nil {
+			t.Error("ValidateLink() to a missing bean should fail")
+		}
+		if cycle := core.DetectCycle("aaa1", "caused_by", "ccc3"); cycle != nil {
+			t.Errorf("DetectCycle() = %v, want none", cycle)
+		}
+	})
+
+	t.Run("fix and remove", func(t *testing.T) {
+		fixed, err := core.FixBrokenLinks()
+		if err != nil {
+			t.Fatalf("FixBrokenLinks error: %v", err)
+		}
+		if fixed != 2 {
+			t.Errorf("fixed = %d, want 2", fixed)
+		}
+		a, _ := core.Get("aaa1")
+		if got := a.Links["relates_to"]; len(got) != 1 || got[0] != "ccc3" {
+			t.Errorf("relates_to = %v, want [ccc3]", got)
+		}
+
+		removed, err := core.RemoveLinksTo("ccc3")
+		if err != nil {
+			t.Fatalf("RemoveLinksTo error: %v", err)
+		}
+		if removed != 1 || a.Links["relates_to"] != nil {
+			t.Errorf("RemoveLinksTo() = %d, links = %v", removed, a.Links)
+		}
+	})
+}
+
 func TestLinkCheckResultMethods(t *testing.T) {
 	t.Run("empty result", func(t *testing.T) {
 		r := &LinkCheckResult{
@@ -832,6 +997,13 @@ func TestImplicitBlocking(t *testing.T) {
 }
 
 func TestIsResolvedStatus(t *testing.T) {
+	core, _ := setupTestCore(t)
+	archive := true
+	core.Config().CustomStatuses = []config.CustomStatusConfig{
+		{Name: "wontfix", Archive: &archive},
+		{Name: "in-review"},
+	}
+
 	tests := []struct {
 		status string
 		want   bool
@@ -841,12 +1013,14 @@ func TestIsResolvedStatus(t *testing.T) {
 		{"todo", false},
 		{"in-progress", false},
 		{"draft", false},
+		{"wontfix", true},
+		{"in-review", false},
 		{"", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.status, func(t *testing.T) {
-			got := isResolvedStatus(tt.status)
+			got := core.isResolvedStatus(tt.status)
 			if got != tt.want {
 				t.Errorf("isResolvedStatus(%q) = %v, want %v", tt.status, got, tt.want)
 			}
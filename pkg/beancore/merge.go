@@ -0,0 +1,184 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// MergeResult describes the changes made by Merge.
+type MergeResult struct {
+	// Into is the bean the duplicate was merged into.
+	Into *bean.Bean
+	// Duplicate is the merged bean, now scrapped and linked to Into.
+	Duplicate *bean.Bean
+	// LinksRewritten counts the incoming links moved from the duplicate to Into.
+	LinksRewritten int
+	// Skipped describes links that were dropped rather than moved because
+	// they would have created a cycle. Links that would have made a bean
+	// reference itself are dropped silently.
+	Skipped []string
+}
+
+// Merge folds the duplicate bean dupID into intoID. The duplicate's tags and
+// blocking relationships move onto the target, its body is appended to the
+// target's body below a provenance note, and all incoming links are rewritten
+// to point at the target. The duplicate is then marked scrapped with a
+// duplicates link to the target.
+func (c *Core) Merge(dupID, intoID string) (*MergeResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	dup, ok := c.beans[c.normalizeID(dupID)]
+	if !ok {
+		return nil, fmt.Errorf("duplicate bean not found: %s", dupID)
+	}
+	into, ok := c.beans[c.normalizeID(intoID)]
+	if !ok {
+		return nil, fmt.Errorf("target bean not found: %s", intoID)
+	}
+	if dup.ID == into.ID {
+		return nil, fmt.Errorf("cannot merge a bean into itself")
+	}
+	if dup.HasLink(config.LinkDuplicates, into.ID) && dup.Status == "scrapped" {
+		return nil, fmt.Errorf("%s has already been merged into %s", dup.ID, into.ID)
+	}
+	if cycle := c.detectCycleLocked(dup.ID, config.LinkDuplicates, into.ID); cycle != nil {
+		return nil, fmt.Errorf("cannot merge: %s already duplicates %s (%v)", into.ID, dup.ID, cycle)
+	}
+
+	result := &MergeResult{Into: into, Duplicate: dup}
+	changed := map[string]*bean.Bean{dup.ID: dup, into.ID: into}
+
+	for _, tag := range dup.Tags {
+		// Tags on disk are already valid, so AddTag cannot fail here
+		_ = into.AddTag(tag)
+	}
+	dup.Tags = nil
+
+	// Move blocking relationships in both directions
+	for _, blocker := range dup.BlockedBy {
+		switch {
+		case blocker == into.ID:
+		case c.blockingCycleLocked(blocker, into.ID):
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s blocked_by %s", into.ID, blocker))
+		default:
+			into.AddBlockedBy(blocker)
+		}
+	}
+	for _, blocked := range dup.Blocking {
+		switch {
+		case blocked == into.ID:
+		case c.blockingCycleLocked(into.ID, blocked):
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s blocking %s", into.ID, blocked))
+		default:
+			into.AddBlocking(blocked)
+		}
+	}
+	dup.BlockedBy = nil
+	dup.Blocking = nil
+
+	note := fmt.Sprintf("> Merged from %s (%s)", dup.ID, dup.Title)
+	into.Body = bean.AppendWithSeparator(into.Body, bean.AppendWithSeparator(note, dup.Body))
+
+	for _, b := range c.beans {
+		if b.ID == dup.ID {
+			continue
+		}
+		if c.rewriteLinksLocked(b, dup.ID, into.ID, result) {
+			changed[b.ID] = b
+		}
+	}
+
+	dup.Status = "scrapped"
+	dup.AddLink(config.LinkDuplicates, into.ID)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	dup.UpdatedAt = &now
+	into.UpdatedAt = &now
+
+	c.beginJournalGroup()
+	defer c.endJournalGroup(OpMerge)
+	for _, b := range changed {
+		if err := c.saveToDisk(b); err != nil {
+			return result, err
+		}
+		delete(c.dirty, b.ID)
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(b); err != nil {
+				c.logWarn("failed to update bean %s in search index: %v", b.ID, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// blockingCycleLocked reports whether blocker blocking blocked would create
+// a cycle in either the blocking or the blocked_by graph.
+func (c *Core) blockingCycleLocked(blocker, blocked string) bool {
+	return c.detectCycleLocked(blocker, "blocking", blocked) != nil ||
+		c.detectCycleLocked(blocked, "blocked_by", blocker) != nil
+}
+
+// rewriteLinksLocked points b's links to oldID at newID instead, counting
+// moved links in result. Links that would make b reference itself are
+// dropped; links that would close a cycle are dropped and reported as
+// skipped. Returns true if b changed.
+func (c *Core) rewriteLinksLocked(b *bean.Bean, oldID, newID string, result *MergeResult) bool {
+	changed := false
+	// move reports whether a link of linkType from b may point at newID
+	move := func(linkType string, cycle bool) bool {
+		if b.ID == newID {
+			return false
+		}
+		if cycle {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s %s %s", b.ID, linkType, newID))
+			return false
+		}
+		result.LinksRewritten++
+		return true
+	}
+
+	if b.Parent == oldID {
+		b.Parent = ""
+		changed = true
+		if move("parent", c.detectCycleLocked(b.ID, "parent", newID) != nil) {
+			b.Parent = newID
+		}
+	}
+
+	if b.IsBlocking(oldID) {
+		b.RemoveBlocking(oldID)
+		changed = true
+		if move("blocking", c.blockingCycleLocked(b.ID, newID)) {
+			b.AddBlocking(newID)
+		}
+	}
+
+	if b.IsBlockedBy(oldID) {
+		b.RemoveBlockedBy(oldID)
+		changed = true
+		if move("blocked_by", c.blockingCycleLocked(newID, b.ID)) {
+			b.AddBlockedBy(newID)
+		}
+	}
+
+	acyclic := c.acyclicLinkTypes()
+	for _, linkType := range b.LinkTypes() {
+		if !b.HasLink(linkType, oldID) {
+			continue
+		}
+		b.RemoveLink(linkType, oldID)
+		changed = true
+		cycle := slices.Contains(acyclic, linkType) && c.detectCycleLocked(b.ID, linkType, newID) != nil
+		if move(linkType, cycle) {
+			b.AddLink(linkType, newID)
+		}
+	}
+
+	return changed
+}
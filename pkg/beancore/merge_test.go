@@ -0,0 +1,85 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestMerge(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	beans := []*bean.Bean{
+		{ID: "keep", Title: "Login fails", Status: "todo", Tags: []string{"auth"}, Body: "Original report"},
+		{ID: "dupe", Title: "Cannot log in", Status: "in-progress", Tags: []string{"auth", "urgent"},
+			Body: "Steps to reproduce", BlockedBy: []string{"infra"}, Blocking: []string{"keep", "release"}},
+		{ID: "infra", Title: "Infra", Status: "todo"},
+		{ID: "release", Title: "Release", Status: "todo", Blocking: []string{"keep"}},
+		{ID: "child", Title: "Child", Status: "todo", Parent: "dupe"},
+		{ID: "other", Title: "Other", Status: "todo", Links: map[string][]string{"relates_to": {"dupe"}}},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	result, err := core.Merge("dupe", "keep")
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	keep, _ := core.Get("keep")
+	if !slices.Equal(keep.Tags, []string{"auth", "urgent"}) {
+		t.Errorf("keep.Tags = %v, want [auth urgent]", keep.Tags)
+	}
+	if !slices.Equal(keep.BlockedBy, []string{"infra"}) {
+		t.Errorf("keep.BlockedBy = %v, want [infra]", keep.BlockedBy)
+	}
+	// release already blocks keep, so keep blocking release would be a cycle
+	if len(keep.Blocking) != 0 || len(result.Skipped) != 1 || !strings.Contains(result.Skipped[0], "blocking release") {
+		t.Errorf("keep.Blocking = %v, Skipped = %v, want release skipped", keep.Blocking, result.Skipped)
+	}
+	if !strings.Contains(keep.Body, "Original report") || !strings.Contains(keep.Body, "> Merged from dupe (Cannot log in)\n\nSteps to reproduce") {
+		t.Errorf("keep.Body = %q, want original body followed by merged body", keep.Body)
+	}
+
+	child, _ := core.Get("child")
+	other, _ := core.Get("other")
+	if child.Parent != "keep" || !other.HasLink("relates_to", "keep") || other.HasLink("relates_to", "dupe") {
+		t.Errorf("incoming links not rewritten: child.Parent = %q, other.Links = %v", child.Parent, other.Links)
+	}
+	if result.LinksRewritten != 2 {
+		t.Errorf("LinksRewritten = %d, want 2", result.LinksRewritten)
+	}
+
+	dupe, _ := core.Get("dupe")
+	if dupe.Status != "scrapped" || !dupe.HasLink("duplicates", "keep") {
+		t.Errorf("dupe = status %q, links %v; want scrapped, duplicates keep", dupe.Status, dupe.Links)
+	}
+	if len(dupe.Tags) != 0 || len(dupe.Blocking) != 0 || len(dupe.BlockedBy) != 0 {
+		t.Errorf("dupe still has tags %v, blocking %v, blocked_by %v", dupe.Tags, dupe.Blocking, dupe.BlockedBy)
+	}
+
+	content, err := os.ReadFile(filepath.Join(beansDir, dupe.Path))
+	if err != nil {
+		t.Fatalf("reading merged duplicate: %v", err)
+	}
+	if !strings.Contains(string(content), "status: scrapped") {
+		t.Errorf("merged duplicate not persisted:\n%s", content)
+	}
+
+	if _, err := core.Merge("dupe", "keep"); err == nil || !strings.Contains(err.Error(), "already been merged") {
+		t.Errorf("Merge() again error = %v, want already merged", err)
+	}
+	if _, err := core.Merge("keep", "dupe"); err == nil {
+		t.Error("Merge() back into the duplicate should fail")
+	}
+	if _, err := core.Merge("keep", "keep"); err == nil {
+		t.Error("Merge() into itself should fail")
+	}
+}
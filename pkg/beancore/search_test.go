@@ -2,9 +2,12 @@ package beancore
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
 )
 
 func TestSearch(t *testing.T) {
@@ -273,6 +276,137 @@ func TestClose_ClosesSearchIndex(t *testing.T) {
 	}
 }
 
+func TestSearchHits_IncludesArchived(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	for _, b := range []*bean.Bean{
+		{ID: "aaa1", Title: "Rate limiting", Body: "Throttle login attempts", Status: "todo"},
+		{ID: "bbb2", Title: "Login throttling", Body: "Done in v1", Status: "completed"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if _, err := core.Search("login"); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if err := core.Archive("bbb2"); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+
+	hits, err := core.SearchHits("archived:true", 0)
+	if err != nil {
+		t.Fatalf("SearchHits() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Bean.ID != "bbb2" {
+		t.Fatalf("SearchHits(archived:true) = %v, want [bbb2]", hits)
+	}
+
+	hits, err = core.SearchHits("throttling", 0)
+	if err != nil {
+		t.Fatalf("SearchHits() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Score <= 0 {
+		t.Fatalf("SearchHits(throttling) = %v, want one scored hit", hits)
+	}
+	if title := hits[0].Highlights["title"]; len(title) != 1 || title[0] != "Login **throttling**" {
+		t.Errorf("title highlights = %q", title)
+	}
+}
+
+func setupPersistentSearchCore(t *testing.T, beansDir string) *Core {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Search.PersistentIndex = true
+	core := New(beansDir, cfg)
+	core.SetWarnWriter(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return core
+}
+
+func TestSearch_PersistentIndex(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	core := setupPersistentSearchCore(t, beansDir)
+	b := &bean.Bean{ID: "abc1", Slug: "login", Title: "Login Page", Body: "Frobnicate the widgets"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if results, err := core.Search("Login"); err != nil || len(results) != 1 {
+		t.Fatalf("Search(Login) = %v, %v; want [abc1]", results, err)
+	}
+	if err := core.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(beansDir, CacheDir, "search")); err != nil {
+		t.Fatalf("persistent index not written: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, CacheDir, ".gitignore")); err != nil {
+		t.Errorf("cache directory has no .gitignore: %v", err)
+	}
+
+	// Edit the bean while no core is running; the next one must pick up the
+	// change from the file's new modification time.
+	content := "---\ntitle: Signup Page\nstatus: todo\n---\n"
+	path := filepath.Join(beansDir, b.Path)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	core = setupPersistentSearchCore(t, beansDir)
+	defer core.Close()
+	if results, _ := core.Search("Signup"); len(results) != 1 {
+		t.Errorf("Search(Signup) = %v, want [abc1]", results)
+	}
+	if results, _ := core.Search("Frobnicate"); len(results) != 0 {
+		t.Errorf("Search(Frobnicate) = %v, want [] after the body was removed", results)
+	}
+}
+
+func TestSearch_PersistentIndexFollowsWatch(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	core := setupPersistentSearchCore(t, beansDir)
+	defer core.Close()
+
+	if _, err := core.Search("anything"); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if err := core.StartWatching(); err != nil {
+		t.Fatalf("StartWatching() error = %v", err)
+	}
+	ch, unsub := core.Subscribe()
+	defer unsub()
+	time.Sleep(50 * time.Millisecond)
+
+	content := "---\ntitle: Watched Bean\nstatus: todo\n---\n"
+	if err := writeTestFile(beansDir, "wat1--watched.md", content); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-ch:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for watch event")
+	}
+
+	if results, _ := core.Search("Watched"); len(results) != 1 {
+		t.Errorf("Search(Watched) = %v, want [wat1]", results)
+	}
+}
+
 // Helper to write test files
 func writeTestFile(dir, name, content string) error {
 	return os.WriteFile(dir+"/"+name, []byte(content), 0644)
@@ -0,0 +1,132 @@
+package beancore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/embedding"
+	"github.com/hmans/beans/pkg/bean"
+)
+
+// DefaultSimilarLimit is the number of similar beans returned when no limit
+// is given.
+const DefaultSimilarLimit = 5
+
+// SimilarBean is a bean similar to another one, with the cosine similarity of
+// their embeddings (1 is identical).
+type SimilarBean struct {
+	Bean  *bean.Bean `json:"bean"`
+	Score float64    `json:"score"`
+}
+
+// Similar returns up to limit beans (0 for DefaultSimilarLimit) whose title,
+// tags and body are most similar to those of the bean with the given ID, best
+// first. Archived beans are included, so that duplicates of completed work
+// are found too. Only beans with a positive similarity are returned.
+func (c *Core) Similar(id string, limit int) ([]SimilarBean, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultSimilarLimit
+	}
+
+	idx, err := c.similarityIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var result []SimilarBean
+	for _, m := range idx.Similar(b.ID, 0, 0) {
+		if other, ok := c.beans[m.ID]; ok {
+			result = append(result, SimilarBean{Bean: other, Score: m.Score})
+			if len(result) == limit {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// FindDuplicates returns up to DefaultSimilarLimit existing beans, open or
+// archived, whose title, tags and body are more similar than threshold to
+// those given, best first. It is meant to be called before creating a bean
+// from them. Since embeddings depend on the whole corpus, this embeds all beans
+// together with the new text, without reusing the similarity index.
+func (c *Core) FindDuplicates(title string, tags []string, body string, threshold float64) ([]SimilarBean, error) {
+	docs := c.similarityDocuments()
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	// Bean IDs are never empty, so the new text can't collide with a bean
+	docs = append(docs, embedding.Document{Text: similarityText(title, tags, body)})
+	idx, err := embedding.Build(c.embedder(), docs)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var result []SimilarBean
+	for _, m := range idx.Similar("", 0, threshold) {
+		if b, ok := c.beans[m.ID]; ok {
+			result = append(result, SimilarBean{Bean: b, Score: m.Score})
+			if len(result) == DefaultSimilarLimit {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// similarityIndex returns the embedding index of all beans, building it if
+// the bean texts changed since it was last built.
+func (c *Core) similarityIndex() (*embedding.Index, error) {
+	docs := c.similarityDocuments()
+
+	c.similarityMu.Lock()
+	defer c.similarityMu.Unlock()
+
+	if c.similarity != nil && c.similarity.Fingerprint() == embedding.Fingerprint(docs) {
+		return c.similarity, nil
+	}
+	idx, err := embedding.Build(c.embedder(), docs)
+	if err != nil {
+		return nil, err
+	}
+	c.similarity = idx
+	return idx, nil
+}
+
+// similarityDocuments returns the text of each bean to embed, sorted by ID so
+// that the index fingerprint only depends on the beans' contents.
+func (c *Core) similarityDocuments() []embedding.Document {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	docs := make([]embedding.Document, 0, len(c.beans))
+	for _, b := range c.beans {
+		docs = append(docs, embedding.Document{ID: b.ID, Text: similarityText(b.Title, b.Tags, b.Body)})
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+	return docs
+}
+
+// similarityText returns the text a bean is embedded by.
+func similarityText(title string, tags []string, body string) string {
+	return title + "\n" + strings.Join(tags, " ") + "\n" + body
+}
+
+// embedder returns the configured embedding backend: the
+// search.embedding_command if set, or the built-in LSA embedder.
+func (c *Core) embedder() embedding.Embedder {
+	if c.config != nil && c.config.Search.EmbeddingCommand != "" {
+		return embedding.Command{Command: c.config.Search.EmbeddingCommand, Dir: c.config.ConfigDir()}
+	}
+	return embedding.LSA{}
+}
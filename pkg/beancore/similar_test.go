@@ -0,0 +1,157 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestSimilar(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Login broken", Body: "The login form shows an auth error after submitting the password"},
+		{ID: "bbb2", Title: "Auth failure", Body: "Users get an auth error and cannot sign in with their password"},
+		{ID: "ccc3", Title: "Database migration", Body: "Add a migration for the orders table and its index"},
+		{ID: "ddd4", Title: "Slow migration", Body: "The orders table migration rebuilds the index too slowly"},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	similar, err := core.Similar("aaa1", 0)
+	if err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	if len(similar) == 0 || similar[0].Bean.ID != "bbb2" {
+		t.Fatalf("Similar(aaa1) = %v, want bbb2 first", similar)
+	}
+	for _, s := range similar {
+		if s.Bean.ID == "aaa1" {
+			t.Error("Similar() returned the bean itself")
+		}
+	}
+
+	if similar, err := core.Similar("aaa1", 1); err != nil || len(similar) != 1 {
+		t.Errorf("Similar(limit 1) = %v, %v, want 1 result", similar, err)
+	}
+
+	if _, err := core.Similar("nope", 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Similar(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSimilar_RebuildsAfterChanges(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	for _, b := range []*bean.Bean{
+		{ID: "aaa1", Title: "Login broken", Body: "Auth error on the login form"},
+		{ID: "bbb2", Title: "Database migration", Body: "Migration for the orders table"},
+		{ID: "ccc3", Title: "Slow migration", Body: "Orders table migration is slow"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if _, err := core.Similar("aaa1", 0); err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	first := core.similarity
+
+	if err := core.Create(&bean.Bean{ID: "ddd4", Title: "Auth failure", Body: "Auth error when signing in on the login form"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	similar, err := core.Similar("aaa1", 0)
+	if err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	if core.similarity == first {
+		t.Error("expected the similarity index to be rebuilt after creating a bean")
+	}
+	if len(similar) == 0 || similar[0].Bean.ID != "ddd4" {
+		t.Errorf("Similar(aaa1) = %v, want the new bean ddd4 first", similar)
+	}
+
+	// Unchanged beans reuse the index
+	idx := core.similarity
+	if _, err := core.Similar("bbb2", 0); err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	if core.similarity != idx {
+		t.Error("expected the similarity index to be reused when no bean changed")
+	}
+}
+
+func TestSimilar_EmbeddingCommand(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	defer core.Close()
+
+	// Embeds every text as [1, 0], except texts mentioning "other" as [0, 1]
+	configDir := filepath.Dir(beansDir)
+	script := "#!/bin/sh\nsed -e 's/\"[^\"]*other[^\"]*\"/[0,1]/g' -e 's/\"[^\"]*\"/[1,0]/g'\n"
+	if err := os.WriteFile(filepath.Join(configDir, "embed.sh"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	core.config.SetConfigDir(configDir)
+	core.config.Search.EmbeddingCommand = "./embed.sh"
+
+	for _, b := range []*bean.Bean{
+		{ID: "aaa1", Title: "First"},
+		{ID: "bbb2", Title: "Second"},
+		{ID: "ccc3", Title: "The other one"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	similar, err := core.Similar("aaa1", 0)
+	if err != nil {
+		t.Fatalf("Similar() error = %v", err)
+	}
+	if len(similar) != 1 || similar[0].Bean.ID != "bbb2" || similar[0].Score != 1 {
+		t.Errorf("Similar(aaa1) = %v, want [bbb2 1]", similar)
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	core, _ := setupTestCore(t)
+	defer core.Close()
+
+	if dups, err := core.FindDuplicates("Login broken", nil, "", 0.5); err != nil || len(dups) != 0 {
+		t.Errorf("FindDuplicates() without beans = %v, %v, want none", dups, err)
+	}
+
+	for _, b := range []*bean.Bean{
+		{ID: "aaa1", Title: "Login broken", Body: "The login form shows an auth error after submitting the password"},
+		{ID: "bbb2", Title: "Database migration", Body: "Add a migration for the orders table and its index"},
+		{ID: "ccc3", Title: "Slow migration", Body: "The orders table migration rebuilds the index too slowly"},
+		{ID: "ddd4", Title: "Dark mode", Body: "Support a dark color scheme in the settings"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if err := core.Archive("aaa1"); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+
+	dups, err := core.FindDuplicates("Auth failure", nil, "Users get an auth error on the login form", 0.5)
+	if err != nil {
+		t.Fatalf("FindDuplicates() error = %v", err)
+	}
+	if len(dups) != 1 || dups[0].Bean.ID != "aaa1" {
+		t.Fatalf("FindDuplicates() = %v, want the archived aaa1", dups)
+	}
+
+	if dups, _ := core.FindDuplicates("Keyboard shortcuts", nil, "Quit with a shortcut key", 0.5); len(dups) != 0 {
+		t.Errorf("FindDuplicates() for an unrelated text = %v, want none", dups)
+	}
+}
@@ -0,0 +1,273 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+var (
+	// ErrTxDone is returned when committing a transaction that was already
+	// committed or rolled back.
+	ErrTxDone = errors.New("transaction already committed or rolled back")
+	// ErrTxConflict is returned by Commit when beans changed by the
+	// transaction were also changed outside it since it began.
+	ErrTxConflict = errors.New("beans changed outside the transaction")
+	// ErrNotInTx is returned by operations that cannot be staged inside a
+	// transaction, such as archiving.
+	ErrNotInTx = errors.New("operation not supported inside a transaction")
+)
+
+// Tx is a transaction: a staged copy of the bean graph that receives creates,
+// updates and deletes without touching disk. Validation inside the
+// transaction (links, cycles, parent types) sees the staged graph. Commit
+// writes every changed file at once, or none of them.
+//
+//	tx := core.Begin()
+//	defer tx.Rollback()
+//	if err := tx.Core().Create(epic); err != nil { ... }
+//	if err := tx.Core().Create(child); err != nil { ... }
+//	return tx.Commit()
+type Tx struct {
+	core   *Core
+	staged *Core
+	base   map[string]*bean.Bean // beans as they were when the transaction began
+	done   bool
+	nested bool
+}
+
+// Begin starts a transaction. Use Tx.Core for reads and writes within it.
+// Beginning a transaction on the staged core of another one nests it: its
+// changes are staged directly in the enclosing transaction, and Commit and
+// Rollback leave them to the enclosing transaction.
+func (c *Core) Begin() *Tx {
+	if c.overlay != nil {
+		return &Tx{core: c, staged: c, nested: true}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	staged := New(c.root, c.config)
+	staged.warnWriter = nil
+	staged.overlay = make(map[string][]byte)
+
+	base := make(map[string]*bean.Bean, len(c.beans))
+	for id, b := range c.beans {
+		base[id] = b.Clone()
+		staged.beans[id] = b.Clone()
+	}
+
+	return &Tx{core: c, staged: staged, base: base}
+}
+
+// Core returns the staged copy of the graph. Changes made through it are
+// only written to disk by Commit.
+func (tx *Tx) Core() *Core {
+	return tx.staged
+}
+
+// Rollback discards the staged changes. It is a no-op after Commit, so it
+// can be deferred.
+func (tx *Tx) Rollback() {
+	tx.done = true
+}
+
+// txWrite is a staged file write, or a deletion if content is nil.
+type txWrite struct {
+	id       string
+	bean     *bean.Bean
+	path     string // absolute destination
+	worktree string // worktree the bean is linked to, if any
+	content  []byte
+	tmp      string // temporary file holding content until it is renamed into place
+	original []byte // content before the commit, nil if the file did not exist
+}
+
+// Commit writes the staged changes to disk and applies them to the core.
+// Fails with ErrTxConflict, writing nothing, if any bean changed by the
+// transaction was also changed outside it since Begin.
+func (tx *Tx) Commit() error {
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	if tx.nested {
+		return nil
+	}
+
+	c := tx.core
+	staged := tx.staged
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	idByPath := make(map[string]string)
+	for id, b := range tx.base {
+		idByPath[b.Path] = id
+	}
+	for id, b := range staged.beans {
+		idByPath[b.Path] = id
+	}
+
+	var writes []*txWrite
+	var conflicts []string
+	for _, relPath := range slices.Sorted(maps.Keys(staged.overlay)) {
+		id := idByPath[relPath]
+		if _, existed := tx.base[id]; !existed && staged.overlay[relPath] == nil {
+			// Created and deleted within the transaction
+			continue
+		}
+		current, exists := c.beans[id]
+		if original, existed := tx.base[id]; existed != exists || (exists && current.ETag() != original.ETag()) {
+			conflicts = append(conflicts, id)
+			continue
+		}
+
+		w := &txWrite{id: id, bean: staged.beans[id], path: filepath.Join(c.root, relPath), content: staged.overlay[relPath]}
+		// Beans linked to a worktree are written there, as Update does
+		if wt := c.worktreeLinks[id]; wt != "" && w.content != nil {
+			w.worktree = wt
+			w.path = filepath.Join(wt, BeansDir, bean.BuildFilename(id, w.bean.Slug))
+		}
+		writes = append(writes, w)
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s", ErrTxConflict, strings.Join(conflicts, ", "))
+	}
+
+	if err := writeFilesAtomically(writes); err != nil {
+		return err
+	}
+
+	var changes []FileChange
+	for _, w := range writes {
+		if w.worktree == "" {
+			relPath, _ := filepath.Rel(c.root, w.path)
+			changes = append(changes, FileChange{Path: relPath, Before: string(w.original), After: string(w.content)})
+		}
+	}
+	c.record("", changes...)
+
+	for _, w := range writes {
+		if w.content == nil {
+			delete(c.beans, w.id)
+			delete(c.dirty, w.id)
+			if c.searchIndex != nil {
+				if err := c.searchIndex.DeleteBean(w.id); err != nil {
+					c.logWarn("failed to remove bean %s from search index: %v", w.id, err)
+				}
+			}
+			continue
+		}
+
+		// Keep the bean pointers callers already hold valid
+		if current, ok := c.beans[w.id]; ok {
+			*current = *w.bean
+			w.bean = current
+		} else {
+			c.beans[w.id] = w.bean
+		}
+		if w.worktree != "" {
+			c.dirty[w.id] = true
+		} else {
+			delete(c.dirty, w.id)
+		}
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(w.bean); err != nil {
+				c.logWarn("failed to update bean %s in search index: %v", w.id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeFilesAtomically writes each file to a temporary file next to its
+// destination, then renames them all into place and removes deleted files.
+// If any step fails, files already replaced are restored, so either every
+// change lands or none does.
+func writeFilesAtomically(writes []*txWrite) error {
+	removeTemps := func() {
+		for _, w := range writes {
+			if w.tmp != "" {
+				_ = os.Remove(w.tmp)
+				w.tmp = ""
+			}
+		}
+	}
+
+	// Stage every file first; nothing visible has changed yet
+	for _, w := range writes {
+		original, err := os.ReadFile(w.path)
+		if err != nil && !os.IsNotExist(err) {
+			removeTemps()
+			return fmt.Errorf("reading %s: %w", w.path, err)
+		}
+		w.original = original
+
+		if w.content == nil {
+			continue
+		}
+		if err := stageFile(w); err != nil {
+			removeTemps()
+			return err
+		}
+	}
+
+	for i, w := range writes {
+		var err error
+		if w.content == nil {
+			if err = os.Remove(w.path); os.IsNotExist(err) {
+				err = nil
+			}
+		} else if err = os.Rename(w.tmp, w.path); err == nil {
+			w.tmp = ""
+		}
+		if err != nil {
+			restoreFiles(writes[:i])
+			removeTemps()
+			return fmt.Errorf("committing %s: %w", w.path, err)
+		}
+	}
+
+	return nil
+}
+
+// stageFile writes w's content to a temporary file in the destination
+// directory, so the final rename cannot cross filesystems.
+func stageFile(w *txWrite) error {
+	dir := filepath.Dir(w.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, ".beans-tx-*.tmp")
+	if err != nil {
+		return fmt.Errorf("staging %s: %w", w.path, err)
+	}
+	w.tmp = f.Name()
+	if _, err := f.Write(w.content); err != nil {
+		f.Close()
+		return fmt.Errorf("staging %s: %w", w.path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("staging %s: %w", w.path, err)
+	}
+	return os.Chmod(w.tmp, 0644)
+}
+
+// restoreFiles puts back the original content of files already committed.
+func restoreFiles(writes []*txWrite) {
+	for _, w := range writes {
+		if w.original != nil {
+			_ = os.WriteFile(w.path, w.original, 0644)
+		} else {
+			_ = os.Remove(w.path)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/pkg/bean"
+)
+
+func TestTx(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	gone := &bean.Bean{ID: "gone", Title: "Gone", Status: "todo"}
+	keep := &bean.Bean{ID: "keep", Title: "Keep", Status: "todo"}
+	for _, b := range []*bean.Bean{gone, keep} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	tx := core.Begin()
+	defer tx.Rollback()
+	staged := tx.Core()
+
+	epic := &bean.Bean{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"}
+	child := &bean.Bean{ID: "child", Title: "Child", Status: "todo", Parent: "epic"}
+	for _, b := range []*bean.Bean{epic, child} {
+		if err := staged.Create(b); err != nil {
+			t.Fatalf("staged Create(%s) error = %v", b.ID, err)
+		}
+	}
+	stagedKeep, _ := staged.Get("keep")
+	stagedKeep.Status = "completed"
+	if err := staged.Update(stagedKeep, nil); err != nil {
+		t.Fatalf("staged Update() error = %v", err)
+	}
+	if err := staged.Delete("gone"); err != nil {
+		t.Fatalf("staged Delete() error = %v", err)
+	}
+	if err := staged.Archive("keep"); !errors.Is(err, ErrNotInTx) {
+		t.Errorf("staged Archive() error = %v, want ErrNotInTx", err)
+	}
+
+	// Staged reads see the transaction; the core and disk do not
+	if _, err := staged.Get("child"); err != nil {
+		t.Errorf("staged Get(child) error = %v", err)
+	}
+	if _, err := core.Get("child"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(child) before commit error = %v, want ErrNotFound", err)
+	}
+	if b, _ := core.Get("keep"); b.Status != "todo" {
+		t.Errorf("keep.Status before commit = %q, want todo", b.Status)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, child.Path)); !os.IsNotExist(err) {
+		t.Errorf("child written before commit: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	for _, id := range []string{"epic", "child"} {
+		b, err := core.Get(id)
+		if err != nil {
+			t.Fatalf("Get(%s) after commit error = %v", id, err)
+		}
+		if _, err := os.Stat(filepath.Join(beansDir, b.Path)); err != nil {
+			t.Errorf("%s not written: %v", id, err)
+		}
+	}
+	if b, _ := core.Get("keep"); b.Status != "completed" {
+		t.Errorf("keep.Status after commit = %q, want completed", b.Status)
+	}
+	if _, err := core.Get("gone"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(gone) after commit error = %v, want ErrNotFound", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, gone.Path)); !os.IsNotExist(err) {
+		t.Errorf("gone still on disk: %v", err)
+	}
+	if err := tx.Commit(); !errors.Is(err, ErrTxDone) {
+		t.Errorf("second Commit() error = %v, want ErrTxDone", err)
+	}
+}
+
+func TestTxConflict(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	b := &bean.Bean{ID: "shared", Title: "Shared", Status: "todo"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tx := core.Begin()
+	if err := tx.Core().Create(&bean.Bean{ID: "new", Title: "New", Status: "todo"}); err != nil {
+		t.Fatalf("staged Create() error = %v", err)
+	}
+	staged, _ := tx.Core().Get("shared")
+	staged.Title = "Changed in transaction"
+	if err := tx.Core().Update(staged, nil); err != nil {
+		t.Fatalf("staged Update() error = %v", err)
+	}
+
+	// Someone else changes the bean before the transaction commits
+	b.Title = "Changed outside"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if err := tx.Commit(); !errors.Is(err, ErrTxConflict) {
+		t.Fatalf("Commit() error = %v, want ErrTxConflict", err)
+	}
+	if got, _ := core.Get("shared"); got.Title != "Changed outside" {
+		t.Errorf("shared.Title = %q, want the outside change kept", got.Title)
+	}
+	if _, err := core.Get("new"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(new) error = %v, want nothing committed", err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(beansDir, "*.md")); len(files) != 1 {
+		t.Errorf("beans dir has %d bean files, want only the shared bean", len(files))
+	}
+}
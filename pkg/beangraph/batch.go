@@ -0,0 +1,176 @@
+package beangraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+)
+
+// Batch applies operations in order inside a single transaction. Each
+// operation sees the beans created and changed by the ones before it, and a
+// create with a ref can be referred to as $ref by later operations. If any
+// operation fails or the commit conflicts with a concurrent change, nothing
+// is written. Returns one bean per operation, nil for deletes.
+func (r *CoreResolver) Batch(ctx context.Context, operations []*model.BatchOperation) ([]*bean.Bean, error) {
+	tx := r.Core.Begin()
+	defer tx.Rollback()
+
+	staged := &CoreResolver{Core: tx.Core()}
+	refs := &batchRefs{ids: make(map[string]string)}
+
+	results := make([]*bean.Bean, len(operations))
+	for i, op := range operations {
+		b, err := staged.applyBatchOperation(ctx, op, refs)
+		if err != nil {
+			return nil, fmt.Errorf("operations[%d]: %w", i, err)
+		}
+		results[i] = b
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	// Return the committed beans rather than their staged copies
+	for i, b := range results {
+		if b != nil {
+			results[i], _ = r.Core.Get(b.ID)
+		}
+	}
+	return results, nil
+}
+
+// applyBatchOperation runs a single batch operation, resolving refs to
+// earlier creates.
+func (r *CoreResolver) applyBatchOperation(ctx context.Context, op *model.BatchOperation, refs *batchRefs) (*bean.Bean, error) {
+	set := 0
+	for _, isSet := range []bool{op.Create != nil, op.Update != nil, op.AddLink != nil, op.Delete != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of create, update, addLink or delete must be set")
+	}
+
+	switch {
+	case op.Create != nil:
+		var name string
+		if op.Ref != nil {
+			name = strings.TrimPrefix(*op.Ref, "$")
+			if name == "" {
+				return nil, fmt.Errorf("ref cannot be empty")
+			}
+			if _, taken := refs.ids[name]; taken {
+				return nil, fmt.Errorf("ref $%s is already used by an earlier operation", name)
+			}
+		}
+
+		input := *op.Create
+		input.Parent = refs.ptr(input.Parent)
+		input.Blocking = refs.list(input.Blocking)
+		input.BlockedBy = refs.list(input.BlockedBy)
+		input.Links = refs.links(input.Links)
+		if refs.err != nil {
+			return nil, refs.err
+		}
+
+		b, err := r.CreateBean(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			refs.ids[name] = b.ID
+		}
+		return b, nil
+
+	case op.Ref != nil:
+		return nil, fmt.Errorf("ref can only be set on create operations")
+
+	case op.Update != nil:
+		if op.Update.Input == nil {
+			return nil, fmt.Errorf("update input is required")
+		}
+		id := refs.id(op.Update.ID)
+		input := *op.Update.Input
+		input.Parent = refs.ptr(input.Parent)
+		input.AddBlocking = refs.list(input.AddBlocking)
+		input.RemoveBlocking = refs.list(input.RemoveBlocking)
+		input.AddBlockedBy = refs.list(input.AddBlockedBy)
+		input.RemoveBlockedBy = refs.list(input.RemoveBlockedBy)
+		input.AddLinks = refs.links(input.AddLinks)
+		input.RemoveLinks = refs.links(input.RemoveLinks)
+		if refs.err != nil {
+			return nil, refs.err
+		}
+		return r.UpdateBean(ctx, id, input)
+
+	case op.AddLink != nil:
+		id, targetID := refs.id(op.AddLink.ID), refs.id(op.AddLink.TargetID)
+		if refs.err != nil {
+			return nil, refs.err
+		}
+		return r.AddLink(ctx, id, op.AddLink.Type, targetID, op.AddLink.IfMatch)
+
+	default:
+		id := refs.id(*op.Delete)
+		if refs.err != nil {
+			return nil, refs.err
+		}
+		if _, err := r.DeleteBean(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+}
+
+// batchRefs maps the refs of beans created earlier in a batch to their IDs.
+// Resolving an unknown ref records an error in err.
+type batchRefs struct {
+	ids map[string]string
+	err error
+}
+
+// id resolves s if it is a $ref, and returns it unchanged otherwise.
+func (r *batchRefs) id(s string) string {
+	if !strings.HasPrefix(s, "$") {
+		return s
+	}
+	id, ok := r.ids[s[1:]]
+	if !ok && r.err == nil {
+		r.err = fmt.Errorf("unknown ref %s", s)
+	}
+	return id
+}
+
+func (r *batchRefs) ptr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	id := r.id(*s)
+	return &id
+}
+
+func (r *batchRefs) list(ids []string) []string {
+	if ids == nil {
+		return nil
+	}
+	resolved := make([]string, len(ids))
+	for i, id := range ids {
+		resolved[i] = r.id(id)
+	}
+	return resolved
+}
+
+func (r *batchRefs) links(links []*model.LinkInput) []*model.LinkInput {
+	if links == nil {
+		return nil
+	}
+	resolved := make([]*model.LinkInput, len(links))
+	for i, link := range links {
+		resolved[i] = &model.LinkInput{Type: link.Type, TargetID: r.id(link.TargetID)}
+	}
+	return resolved
+}
@@ -2,11 +2,14 @@ package beangraph
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/config"
 )
 
 // BeanIsDirty returns whether a bean has unsaved runtime changes.
@@ -14,6 +17,12 @@ func (r *CoreResolver) BeanIsDirty(ctx context.Context, obj *bean.Bean) (bool, e
 	return r.Core.IsDirty(obj.ID), nil
 }
 
+// BeanIsOverdue returns whether a bean's due date has passed while it is
+// still open.
+func (r *CoreResolver) BeanIsOverdue(ctx context.Context, obj *bean.Bean) (bool, error) {
+	return r.Core.IsOverdue(obj, time.Now()), nil
+}
+
 // BeanWorktreeID returns the worktree ID for a bean, or nil if not linked.
 func (r *CoreResolver) BeanWorktreeID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	wtPath := r.Core.WorktreeForBean(obj.ID)
@@ -25,6 +34,15 @@ func (r *CoreResolver) BeanWorktreeID(ctx context.Context, obj *bean.Bean) (*str
 	return &id, nil
 }
 
+// BeanFields returns the bean's custom field values as a map.
+func (r *CoreResolver) BeanFields(ctx context.Context, obj *bean.Bean) (map[string]any, error) {
+	fields := make(map[string]any, len(obj.Fields))
+	for name, value := range obj.Fields {
+		fields[name] = value
+	}
+	return fields, nil
+}
+
 // BeanParentID returns the parent ID as a pointer, or nil if no parent.
 func (r *CoreResolver) BeanParentID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	if obj.Parent == "" {
@@ -43,6 +61,17 @@ func (r *CoreResolver) BeanBlockedByIds(ctx context.Context, obj *bean.Bean) ([]
 	return obj.BlockedBy, nil
 }
 
+// BeanLinks returns the bean's typed links, ordered by link type.
+func (r *CoreResolver) BeanLinks(ctx context.Context, obj *bean.Bean) ([]*model.BeanLink, error) {
+	links := []*model.BeanLink{}
+	for _, linkType := range obj.LinkTypes() {
+		for _, targetID := range obj.Links[linkType] {
+			links = append(links, &model.BeanLink{Type: linkType, TargetID: targetID})
+		}
+	}
+	return links, nil
+}
+
 // BeanBlockedBy resolves the full list of beans blocking this one.
 // Combines both directions: the bean's own blocked_by field AND incoming
 // blocking links (other beans that list this bean in their blocking field).
@@ -69,7 +98,10 @@ func (r *CoreResolver) BeanBlockedBy(ctx context.Context, obj *bean.Bean, filter
 		}
 	}
 
-	filtered := ApplyFilter(result, filter, r.Core)
+	filtered, err := ApplyFilter(result, filter, r.Core)
+	if err != nil {
+		return nil, err
+	}
 	cfg := r.Core.Config()
 	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
 	return filtered, nil
@@ -84,7 +116,10 @@ func (r *CoreResolver) BeanBlocking(ctx context.Context, obj *bean.Bean, filter
 			result = append(result, target)
 		}
 	}
-	filtered := ApplyFilter(result, filter, r.Core)
+	filtered, err := ApplyFilter(result, filter, r.Core)
+	if err != nil {
+		return nil, err
+	}
 	cfg := r.Core.Config()
 	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
 	return filtered, nil
@@ -112,12 +147,145 @@ func (r *CoreResolver) BeanChildren(ctx context.Context, obj *bean.Bean, filter
 			result = append(result, link.FromBean)
 		}
 	}
-	filtered := ApplyFilter(result, filter, r.Core)
+	filtered, err := ApplyFilter(result, filter, r.Core)
+	if err != nil {
+		return nil, err
+	}
+	cfg := r.Core.Config()
+	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	return filtered, nil
+}
+
+// BeanRelatesTo resolves related beans in either direction.
+func (r *CoreResolver) BeanRelatesTo(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.BeanLinked(ctx, obj, config.LinkRelatesTo, nil, filter)
+}
+
+// BeanDuplicates resolves the beans this one duplicates.
+func (r *CoreResolver) BeanDuplicates(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.BeanLinked(ctx, obj, config.LinkDuplicates, nil, filter)
+}
+
+// BeanDuplicatedBy resolves the beans that duplicate this one.
+func (r *CoreResolver) BeanDuplicatedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	inverse := true
+	return r.BeanLinked(ctx, obj, config.LinkDuplicates, &inverse, filter)
+}
+
+// BeanCausedBy resolves the beans that caused this one.
+func (r *CoreResolver) BeanCausedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return r.BeanLinked(ctx, obj, config.LinkCausedBy, nil, filter)
+}
+
+// BeanCauses resolves the beans caused by this one.
+func (r *CoreResolver) BeanCauses(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	inverse := true
+	return r.BeanLinked(ctx, obj, config.LinkCausedBy, &inverse, filter)
+}
+
+// BeanLinked resolves the beans linked by the given link type, or with
+// inverse set, the beans linking to this one. Broken links are skipped.
+func (r *CoreResolver) BeanLinked(ctx context.Context, obj *bean.Bean, linkType string, inverse *bool, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	var result []*bean.Bean
+	if inverse != nil && *inverse {
+		result = r.Core.FindLinkingBeans(obj.ID, linkType)
+	} else {
+		result = r.Core.FindLinkedBeans(obj.ID, linkType)
+	}
+	filtered, err := ApplyFilter(result, filter, r.Core)
+	if err != nil {
+		return nil, err
+	}
 	cfg := r.Core.Config()
 	bean.SortByStatusPriorityAndType(filtered, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
 	return filtered, nil
 }
 
+// BeanTotalEstimate returns the sum of the estimates of all descendants.
+func (r *CoreResolver) BeanTotalEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	_, total, err := r.descendantEstimates(ctx, obj)
+	return total, err
+}
+
+// BeanCompletedEstimate returns the sum of the estimates of all completed descendants.
+func (r *CoreResolver) BeanCompletedEstimate(ctx context.Context, obj *bean.Bean) (float64, error) {
+	done, _, err := r.descendantEstimates(ctx, obj)
+	return done, err
+}
+
+// descendantEstimates sums estimates across all descendants of obj, walking
+// the hierarchy through BeanChildren.
+func (r *CoreResolver) descendantEstimates(ctx context.Context, obj *bean.Bean) (done, total float64, err error) {
+	var descendants []*bean.Bean
+	seen := map[string]bool{obj.ID: true}
+	queue := []*bean.Bean{obj}
+	for len(queue) > 0 {
+		children, err := r.BeanChildren(ctx, queue[0], nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			if seen[child.ID] {
+				continue
+			}
+			seen[child.ID] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child)
+		}
+	}
+	done, total = bean.SumEstimates(descendants, r.Core.Config().IsArchiveStatus)
+	return done, total, nil
+}
+
+// BeanHistory returns the committed history of a bean, newest first. Returns
+// an empty list if the project is not in a git repository.
+func (r *CoreResolver) BeanHistory(ctx context.Context, obj *bean.Bean) ([]*model.BeanHistoryEntry, error) {
+	entries, err := r.Core.History(obj.ID)
+	if errors.Is(err, beancore.ErrNoHistory) {
+		return []*model.BeanHistoryEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BeanHistoryEntry, len(entries))
+	for i, e := range entries {
+		changes := make([]*bean.FieldChange, len(e.Changes))
+		for j := range e.Changes {
+			changes[j] = &e.Changes[j]
+		}
+		result[i] = &model.BeanHistoryEntry{
+			Commit:      e.Commit,
+			Author:      e.Author,
+			AuthorEmail: e.AuthorEmail,
+			Timestamp:   e.Timestamp,
+			Message:     e.Message,
+			Action:      e.Action,
+			Changes:     changes,
+		}
+	}
+	return result, nil
+}
+
+// BeanSimilar returns the beans most similar to obj, best first.
+func (r *CoreResolver) BeanSimilar(ctx context.Context, obj *bean.Bean, limit *int) ([]*model.SimilarBean, error) {
+	n := beancore.DefaultSimilarLimit
+	if limit != nil {
+		n = *limit
+	}
+	similar, err := r.Core.Similar(obj.ID, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.SimilarBean, len(similar))
+	for i, s := range similar {
+		result[i] = &model.SimilarBean{Bean: s.Bean, Score: s.Score}
+	}
+	return result, nil
+}
+
 // BeanImplicitStatus returns the implicit status inherited from ancestors.
 func (r *CoreResolver) BeanImplicitStatus(ctx context.Context, obj *bean.Bean) (*string, error) {
 	status, _ := r.Core.ImplicitStatus(obj.ID)
@@ -0,0 +1,46 @@
+package beangraph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hmans/beans/pkg/beancore"
+)
+
+// DuplicatesExtension is the key of the GraphQL response extension listing
+// possible duplicates of created beans, by the ID of the created bean.
+const DuplicatesExtension = "duplicates"
+
+// DuplicateCandidate is an existing bean reported as a possible duplicate of
+// a created one.
+type DuplicateCandidate struct {
+	ID     string  `json:"id"`
+	Title  string  `json:"title"`
+	Status string  `json:"status"`
+	Score  float64 `json:"score"`
+}
+
+// NewDuplicateCandidates converts similar beans to duplicate candidates.
+func NewDuplicateCandidates(similar []beancore.SimilarBean) []DuplicateCandidate {
+	candidates := make([]DuplicateCandidate, len(similar))
+	for i, s := range similar {
+		candidates[i] = DuplicateCandidate{ID: s.Bean.ID, Title: s.Bean.Title, Status: s.Bean.Status, Score: s.Score}
+	}
+	return candidates
+}
+
+// reportDuplicates adds the possible duplicates of the created bean with the
+// given ID to the DuplicatesExtension of the response. It must be called
+// within a GraphQL operation.
+func reportDuplicates(ctx context.Context, id string, similar []beancore.SimilarBean) {
+	if len(similar) == 0 {
+		return
+	}
+	// Mutations run one after another, so the map needs no locking
+	duplicates, ok := graphql.GetExtension(ctx, DuplicatesExtension).(map[string][]DuplicateCandidate)
+	if !ok {
+		duplicates = make(map[string][]DuplicateCandidate)
+		graphql.RegisterExtension(ctx, DuplicatesExtension, duplicates)
+	}
+	duplicates[id] = NewDuplicateCandidates(similar)
+}
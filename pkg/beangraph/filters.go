@@ -1,6 +1,10 @@
 package beangraph
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/beancore"
@@ -8,13 +12,29 @@ import (
 
 // ApplyFilter applies BeanFilter to a slice of beans and returns filtered results.
 // This is used by both the top-level beans query and relationship field resolvers.
-func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Core) []*bean.Bean {
+// Fails only if the filter's where expression is invalid.
+func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Core) ([]*bean.Bean, error) {
 	if filter == nil {
-		return beans
+		return beans, nil
 	}
 
 	result := beans
 
+	// Where expression, checked first so that a malformed one is always reported
+	if filter.Where != nil && strings.TrimSpace(*filter.Where) != "" {
+		match, err := compileWhere(*filter.Where, core, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("where: %w", err)
+		}
+		var matched []*bean.Bean
+		for _, b := range result {
+			if match(b) {
+				matched = append(matched, b)
+			}
+		}
+		result = matched
+	}
+
 	// Status filters
 	if len(filter.Status) > 0 {
 		result = filterByField(result, filter.Status, func(b *bean.Bean) string { return b.Status })
@@ -47,6 +67,33 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 		result = excludeByTags(result, filter.ExcludeTags)
 	}
 
+	// Assignee filters
+	if len(filter.Assignee) > 0 {
+		result = filterByAssignee(result, filter.Assignee)
+	}
+	if filter.Unassigned != nil && *filter.Unassigned {
+		result = filterByUnassigned(result)
+	}
+
+	// Custom field filters
+	for _, f := range filter.Fields {
+		result = filterByCustomField(result, f)
+	}
+	if len(filter.ExcludeFields) > 0 {
+		result = excludeByCustomFields(result, filter.ExcludeFields)
+	}
+
+	// Due date filters
+	if filter.DueBefore != nil {
+		result = filterByDue(result, func(due time.Time) bool { return due.Before(*filter.DueBefore) })
+	}
+	if filter.DueAfter != nil {
+		result = filterByDue(result, func(due time.Time) bool { return due.After(*filter.DueAfter) })
+	}
+	if filter.Overdue != nil && *filter.Overdue {
+		result = filterByOverdue(result, core)
+	}
+
 	// Parent filters
 	if filter.HasParent != nil && *filter.HasParent {
 		result = filterByHasParent(result)
@@ -93,12 +140,23 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 		result = filterByNoBlockedBy(result)
 	}
 
+	// Typed link filters
+	for _, f := range filter.HasLinks {
+		result = filterByLink(result, f, core.FindLinkedBeans)
+	}
+	for _, f := range filter.LinkedFrom {
+		result = filterByLink(result, f, core.FindLinkingBeans)
+	}
+	if len(filter.NoLinks) > 0 {
+		result = excludeByLinkTypes(result, filter.NoLinks, core)
+	}
+
 	// Implicit status filter
 	if filter.ExcludeImplicitTerminal != nil && *filter.ExcludeImplicitTerminal {
 		result = filterByNoImplicitTerminal(result, core)
 	}
 
-	return result
+	return result, nil
 }
 
 // filterByField filters beans to include only those where getter returns a value in values (OR logic).
@@ -214,6 +272,131 @@ outer:
 	return result
 }
 
+// filterByAssignee filters beans to include only those assigned to any of the given users (OR logic).
+func filterByAssignee(beans []*bean.Bean, assignees []string) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		for _, a := range assignees {
+			if b.HasAssignee(a) {
+				result = append(result, b)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// filterByUnassigned filters beans to include only those without assignees.
+func filterByUnassigned(beans []*bean.Bean) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if len(b.Assignees) == 0 {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByDue filters beans to those with a due date matching the predicate.
+// Beans without a due date are excluded.
+func filterByDue(beans []*bean.Bean, match func(due time.Time) bool) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if b.DueAt != nil && match(*b.DueAt) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByOverdue filters beans to those past their due date that are not
+// completed or scrapped.
+func filterByOverdue(beans []*bean.Bean, core *beancore.Core) []*bean.Bean {
+	now := time.Now()
+	var result []*bean.Bean
+	for _, b := range beans {
+		if core.IsOverdue(b, now) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// matchesCustomField returns true if the bean's custom field matches the
+// condition: any of the listed values, or set at all when no values are given.
+func matchesCustomField(b *bean.Bean, f *model.FieldFilter) bool {
+	value, ok := b.Fields[f.Name]
+	if !ok {
+		return false
+	}
+	if len(f.Values) == 0 {
+		return true
+	}
+	for _, v := range f.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// filterByCustomField filters beans to include only those matching a custom field condition.
+func filterByCustomField(beans []*bean.Bean, f *model.FieldFilter) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if matchesCustomField(b, f) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// excludeByCustomFields filters beans to exclude those matching any of the custom field conditions.
+func excludeByCustomFields(beans []*bean.Bean, filters []*model.FieldFilter) []*bean.Bean {
+	var result []*bean.Bean
+outer:
+	for _, b := range beans {
+		for _, f := range filters {
+			if matchesCustomField(b, f) {
+				continue outer
+			}
+		}
+		result = append(result, b)
+	}
+	return result
+}
+
+// filterByLink filters beans to include only those with a typed link matching
+// the condition. linked resolves the beans at the other end of the link, in
+// the direction being filtered on.
+func filterByLink(beans []*bean.Bean, f *model.LinkFilter, linked func(beanID, linkType string) []*bean.Bean) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		for _, other := range linked(b.ID, f.Type) {
+			if f.ID == nil || *f.ID == "" || other.ID == *f.ID {
+				result = append(result, b)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// excludeByLinkTypes filters beans to exclude those with typed links of any of the given types.
+func excludeByLinkTypes(beans []*bean.Bean, linkTypes []string, core *beancore.Core) []*bean.Bean {
+	var result []*bean.Bean
+outer:
+	for _, b := range beans {
+		for _, linkType := range linkTypes {
+			if len(core.FindLinkedBeans(b.ID, linkType)) > 0 {
+				continue outer
+			}
+		}
+		result = append(result, b)
+	}
+	return result
+}
+
 // filterByHasParent filters beans to include only those with a parent.
 func filterByHasParent(beans []*bean.Bean) []*bean.Bean {
 	var result []*bean.Bean
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
 )
@@ -105,6 +106,40 @@ type AskUserQuestion struct {
 	Options []*AskUserOption `json:"options"`
 }
 
+// A link operation in a batch.
+type BatchLink struct {
+	// ID of the bean to add the link to
+	ID string `json:"id"`
+	// Link type (relates_to, duplicates, caused_by, or a custom link type)
+	Type string `json:"type"`
+	// ID of the linked bean
+	TargetID string `json:"targetId"`
+	// ETag for optimistic concurrency control (optional)
+	IfMatch *string `json:"ifMatch,omitempty"`
+}
+
+// One operation in a batch. Exactly one of create, update, addLink or delete must be set.
+type BatchOperation struct {
+	// Name for the bean created by this operation; later operations can use $name wherever a bean ID is expected
+	Ref *string `json:"ref,omitempty"`
+	// Create a bean
+	Create *CreateBeanInput `json:"create,omitempty"`
+	// Update a bean
+	Update *BatchUpdate `json:"update,omitempty"`
+	// Add a typed link between two beans
+	AddLink *BatchLink `json:"addLink,omitempty"`
+	// Delete a bean by ID (automatically removes incoming links)
+	Delete *string `json:"delete,omitempty"`
+}
+
+// An update operation in a batch.
+type BatchUpdate struct {
+	// ID of the bean to update
+	ID string `json:"id"`
+	// Changes to apply
+	Input *UpdateBeanInput `json:"input"`
+}
+
 // Represents a change to a bean
 type BeanChangeEvent struct {
 	// Type of change that occurred
@@ -119,7 +154,7 @@ type BeanChangeEvent struct {
 
 // Filter options for querying beans
 type BeanFilter struct {
-	// Full-text search across slug, title, and body using Bleve query syntax.
+	// Full-text search across slug, title, body and tags using Bleve query syntax.
 	//
 	// Examples:
 	// - "login" - exact term match
@@ -132,7 +167,22 @@ type BeanFilter struct {
 	// - "slug:auth" - search only slug field
 	// - "title:login" - search only title field
 	// - "body:auth" - search only body field
+	// - "tags:auth", "type:bug", "status:todo" - match a tag, type or status
+	// - "archived:true" - only archived beans
 	Search *string `json:"search,omitempty"`
+	// Include only beans matching a query expression, combined with the other filters (AND logic).
+	//
+	// Expressions compare fields with =, !=, <, <=, >, >=, ~ (contains), and in (...), and
+	// combine them with AND, OR, NOT and parentheses. A field on its own matches if it is set.
+	// Relationships are followed with dots: parent.type, children.status, blocked_by.status,
+	// or a link type such as duplicates.status. Dates accept YYYY-MM-DD, today, now, or
+	// relative times like -7d; priorities compare by urgency.
+	//
+	// Examples:
+	// - "(type = bug AND priority >= high) OR (type = feature AND tags = security)"
+	// - "parent.type = epic AND NOT archived"
+	// - "status in (todo, draft) AND created > -7d"
+	Where *string `json:"where,omitempty"`
 	// Include only beans with these statuses (OR logic)
 	Status []string `json:"status,omitempty"`
 	// Exclude beans with these statuses
@@ -149,6 +199,10 @@ type BeanFilter struct {
 	Tags []string `json:"tags,omitempty"`
 	// Exclude beans with any of these tags
 	ExcludeTags []string `json:"excludeTags,omitempty"`
+	// Include only beans assigned to any of these users (OR logic, case-insensitive)
+	Assignee []string `json:"assignee,omitempty"`
+	// Include only beans without assignees
+	Unassigned *bool `json:"unassigned,omitempty"`
 	// Include only beans with a parent
 	HasParent *bool `json:"hasParent,omitempty"`
 	// Include only beans with this specific parent ID
@@ -173,8 +227,50 @@ type BeanFilter struct {
 	NoBlocking *bool `json:"noBlocking,omitempty"`
 	// Exclude beans that have explicit blocked-by entries
 	NoBlockedBy *bool `json:"noBlockedBy,omitempty"`
+	// Include only beans with all of these typed links (either direction for symmetric link types)
+	HasLinks []*LinkFilter `json:"hasLinks,omitempty"`
+	// Include only beans that are the target of all of these typed links (id is the linking bean)
+	LinkedFrom []*LinkFilter `json:"linkedFrom,omitempty"`
+	// Exclude beans with typed links of any of these types
+	NoLinks []string `json:"noLinks,omitempty"`
 	// Exclude beans that inherit a terminal status (scrapped or completed) from an ancestor
 	ExcludeImplicitTerminal *bool `json:"excludeImplicitTerminal,omitempty"`
+	// Include only beans matching all of these custom field conditions
+	Fields []*FieldFilter `json:"fields,omitempty"`
+	// Exclude beans matching any of these custom field conditions
+	ExcludeFields []*FieldFilter `json:"excludeFields,omitempty"`
+	// Include only beans due before this time
+	DueBefore *time.Time `json:"dueBefore,omitempty"`
+	// Include only beans due after this time
+	DueAfter *time.Time `json:"dueAfter,omitempty"`
+	// Include only beans whose due date has passed and that are not completed or scrapped
+	Overdue *bool `json:"overdue,omitempty"`
+}
+
+// A commit that changed a bean file
+type BeanHistoryEntry struct {
+	// Commit hash
+	Commit string `json:"commit"`
+	// Commit author name
+	Author string `json:"author"`
+	// Commit author email
+	AuthorEmail string `json:"authorEmail"`
+	// Commit timestamp
+	Timestamp time.Time `json:"timestamp"`
+	// Commit message subject
+	Message string `json:"message"`
+	// What the commit did: created, updated, archived, unarchived, moved or deleted
+	Action string `json:"action"`
+	// Field changes compared to the previous commit
+	Changes []*bean.FieldChange `json:"changes"`
+}
+
+// A typed link from a bean to another bean
+type BeanLink struct {
+	// Link type (relates_to, duplicates, caused_by, or a custom link type)
+	Type string `json:"type"`
+	// ID of the linked bean
+	TargetID string `json:"targetId"`
 }
 
 // Structured body modifications applied atomically.
@@ -209,6 +305,8 @@ type CreateBeanInput struct {
 	Priority *string `json:"priority,omitempty"`
 	// Tags for categorization
 	Tags []string `json:"tags,omitempty"`
+	// Users this bean is assigned to (e.g., email addresses)
+	Assignees []string `json:"assignees,omitempty"`
 	// Markdown body content
 	Body *string `json:"body,omitempty"`
 	// Parent bean ID (validated against type hierarchy)
@@ -217,8 +315,34 @@ type CreateBeanInput struct {
 	Blocking []string `json:"blocking,omitempty"`
 	// Bean IDs that are blocking this bean
 	BlockedBy []string `json:"blockedBy,omitempty"`
+	// Typed links to other beans (validates link type, existence and cycles)
+	Links []*LinkInput `json:"links,omitempty"`
 	// Custom ID prefix (overrides config prefix for this bean)
 	Prefix *string `json:"prefix,omitempty"`
+	// Custom field values (fields must be declared in .beans.yml)
+	Fields []*FieldInput `json:"fields,omitempty"`
+	// Start date (YYYY-MM-DD or RFC 3339)
+	StartAt *string `json:"startAt,omitempty"`
+	// Due date (YYYY-MM-DD or RFC 3339)
+	DueAt *string `json:"dueAt,omitempty"`
+	// Estimated effort in the configured unit
+	Estimate *float64 `json:"estimate,omitempty"`
+}
+
+// A condition on a custom field
+type FieldFilter struct {
+	// Field name
+	Name string `json:"name"`
+	// Match any of these values (OR logic). When omitted, matches beans that have the field set.
+	Values []string `json:"values,omitempty"`
+}
+
+// A custom field value to set on a bean.
+type FieldInput struct {
+	// Field name (declared in .beans.yml)
+	Name string `json:"name"`
+	// Field value, validated against the field's type
+	Value string `json:"value"`
 }
 
 // Input for attaching a file or directory as context to an agent message.
@@ -255,6 +379,34 @@ type ImageInput struct {
 	MediaType string `json:"mediaType"`
 }
 
+// A condition on a typed link
+type LinkFilter struct {
+	// Link type (relates_to, duplicates, caused_by, or a custom link type)
+	Type string `json:"type"`
+	// ID of the bean at the other end of the link (any bean if omitted)
+	ID *string `json:"id,omitempty"`
+}
+
+// A typed link to add to or remove from a bean.
+type LinkInput struct {
+	// Link type (relates_to, duplicates, caused_by, or a custom link type)
+	Type string `json:"type"`
+	// ID of the linked bean
+	TargetID string `json:"targetId"`
+}
+
+// Result of merging a duplicate bean into another
+type MergeBeanResult struct {
+	// The bean the duplicate was merged into
+	Into *bean.Bean `json:"into"`
+	// The merged duplicate, now scrapped with a duplicates link to the target
+	Duplicate *bean.Bean `json:"duplicate"`
+	// Number of incoming links rewritten to point at the target
+	LinksRewritten int `json:"linksRewritten"`
+	// Links dropped instead of rewritten because they would have created a cycle
+	Skipped []string `json:"skipped"`
+}
+
 type Mutation struct {
 }
 
@@ -299,6 +451,32 @@ type ReplaceOperation struct {
 	New string `json:"new"`
 }
 
+// Snippets of one field that matched a search
+type SearchHighlight struct {
+	// Field name: slug, title, body or tags
+	Field string `json:"field"`
+	// Snippets of the field, with matched terms wrapped in **
+	Fragments []string `json:"fragments"`
+}
+
+// A bean matching a full-text search
+type SearchHit struct {
+	// The matching bean
+	Bean *bean.Bean `json:"bean"`
+	// Relevance score (higher is better); only comparable within one search
+	Score float64 `json:"score"`
+	// Matching snippets by field, with matched terms wrapped in ** (Markdown bold)
+	Highlights []*SearchHighlight `json:"highlights"`
+}
+
+// A bean similar to another one
+type SimilarBean struct {
+	// The similar bean
+	Bean *bean.Bean `json:"bean"`
+	// Cosine similarity of the beans' embeddings, up to 1 (identical)
+	Score float64 `json:"score"`
+}
+
 // Tracks real-time activity of a running subagent (Agent tool invocation)
 type SubagentActivity struct {
 	// Unique task identifier for this subagent
@@ -330,6 +508,12 @@ type UpdateBeanInput struct {
 	AddTags []string `json:"addTags,omitempty"`
 	// Remove tags from existing list
 	RemoveTags []string `json:"removeTags,omitempty"`
+	// Replace all assignees (nil preserves existing, mutually exclusive with addAssignees/removeAssignees)
+	Assignees []string `json:"assignees,omitempty"`
+	// Assign additional users
+	AddAssignees []string `json:"addAssignees,omitempty"`
+	// Unassign users
+	RemoveAssignees []string `json:"removeAssignees,omitempty"`
 	// New body content (full replacement, mutually exclusive with bodyMod)
 	Body *string `json:"body,omitempty"`
 	// Structured body modifications (mutually exclusive with body)
@@ -344,8 +528,20 @@ type UpdateBeanInput struct {
 	AddBlockedBy []string `json:"addBlockedBy,omitempty"`
 	// Remove beans from blocked-by list
 	RemoveBlockedBy []string `json:"removeBlockedBy,omitempty"`
+	// Add typed links (validates link type, existence and cycles)
+	AddLinks []*LinkInput `json:"addLinks,omitempty"`
+	// Remove typed links
+	RemoveLinks []*LinkInput `json:"removeLinks,omitempty"`
 	// Fractional index for manual ordering (used by board drag-and-drop)
 	Order *string `json:"order,omitempty"`
+	// Set custom field values (an empty value removes the field)
+	Fields []*FieldInput `json:"fields,omitempty"`
+	// Set start date (YYYY-MM-DD or RFC 3339, empty to clear)
+	StartAt *string `json:"startAt,omitempty"`
+	// Set due date (YYYY-MM-DD or RFC 3339, empty to clear)
+	DueAt *string `json:"dueAt,omitempty"`
+	// Set estimate (0 to clear)
+	Estimate *float64 `json:"estimate,omitempty"`
 	// ETag for optimistic concurrency control (optional)
 	IfMatch *string `json:"ifMatch,omitempty"`
 }
@@ -627,6 +823,67 @@ func (e InteractionType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// How a pull/merge request is merged into its base branch
+type MergeMethod string
+
+const (
+	// Create a merge commit
+	MergeMethodMerge MergeMethod = "MERGE"
+	// Squash all commits into one
+	MergeMethodSquash MergeMethod = "SQUASH"
+	// Rebase the commits onto the base branch
+	MergeMethodRebase MergeMethod = "REBASE"
+)
+
+var AllMergeMethod = []MergeMethod{
+	MergeMethodMerge,
+	MergeMethodSquash,
+	MergeMethodRebase,
+}
+
+func (e MergeMethod) IsValid() bool {
+	switch e {
+	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase:
+		return true
+	}
+	return false
+}
+
+func (e MergeMethod) String() string {
+	return string(e)
+}
+
+func (e *MergeMethod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeMethod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeMethod", str)
+	}
+	return nil
+}
+
+func (e MergeMethod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MergeMethod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MergeMethod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Status of a worktree's post-creation setup command
 type WorktreeSetupStatus string
 
@@ -3,7 +3,9 @@ package beangraph
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/hmans/beans/pkg/bean"
 	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/beancore"
@@ -34,6 +36,26 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 	if len(input.Tags) > 0 {
 		b.Tags = input.Tags
 	}
+	for _, assignee := range input.Assignees {
+		if err := b.AddAssignee(assignee); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.ValidateAndSetFields(b, input.Fields); err != nil {
+		return nil, err
+	}
+	if err := ValidateAndSetDate(&b.StartAt, input.StartAt); err != nil {
+		return nil, err
+	}
+	if err := ValidateAndSetDate(&b.DueAt, input.DueAt); err != nil {
+		return nil, err
+	}
+	if input.Estimate != nil {
+		if *input.Estimate < 0 {
+			return nil, fmt.Errorf("estimate cannot be negative")
+		}
+		b.Estimate = *input.Estimate
+	}
 
 	// Handle parent (with validation)
 	if input.Parent != nil && *input.Parent != "" {
@@ -82,6 +104,11 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 		b.BlockedBy = normalizedBlockedBy
 	}
 
+	// Handle typed links (with validation)
+	if err := r.ValidateAndAddLinks(b, input.Links); err != nil {
+		return nil, err
+	}
+
 	// Handle custom prefix - pre-generate ID if prefix is provided
 	if input.Prefix != nil && *input.Prefix != "" {
 		idLength := 4 // default
@@ -95,10 +122,19 @@ func (r *CoreResolver) CreateBean(ctx context.Context, input model.CreateBeanInp
 		b.ID = id
 	}
 
+	// Look for possible duplicates before the new bean joins the corpus, to
+	// report them in the response. Direct calls (like the CLI's) run their own
+	// check. It is best-effort: if embedding fails, the bean is still created.
+	var duplicates []beancore.SimilarBean
+	if threshold := r.Core.Config().GetDuplicateThreshold(); threshold > 0 && graphql.HasOperationContext(ctx) {
+		duplicates, _ = r.Core.FindDuplicates(b.Title, b.Tags, b.Body, threshold)
+	}
+
 	if err := r.Core.Create(b); err != nil {
 		return nil, err
 	}
 
+	reportDuplicates(ctx, b.ID, duplicates)
 	return b, nil
 }
 
@@ -109,14 +145,89 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 		return nil, err
 	}
 
+	if err := r.applyUpdateInput(b, input); err != nil {
+		return nil, err
+	}
+
+	// ETag validation now happens inside Update() under write lock.
+	// If the bean is linked to a worktree, Core auto-routes the write there.
+	if err := r.Core.Update(b, input.IfMatch, opts...); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// UpdateBeans applies one update to every bean matching filter in a single
+// transaction, so the update applies to every match or to none. With dryRun
+// set, returns the updated beans without writing them.
+func (r *CoreResolver) UpdateBeans(ctx context.Context, filter model.BeanFilter, input model.UpdateBeanInput, dryRun *bool) ([]*bean.Bean, error) {
+	if input.IfMatch != nil {
+		return nil, fmt.Errorf("ifMatch cannot be used when updating several beans")
+	}
+
+	matches, err := r.Beans(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]*bean.Bean, len(matches))
+	for i, b := range matches {
+		updated[i] = b.Clone()
+		if err := r.applyUpdateInput(updated[i], input); err != nil {
+			return nil, fmt.Errorf("%s: %w", b.ID, err)
+		}
+	}
+	if dryRun != nil && *dryRun {
+		return updated, nil
+	}
+
+	// Writes compare against the etag each bean had when it was matched, when
+	// the project requires it, so concurrent edits are not overwritten
+	etagFor := func(b *bean.Bean) *string {
+		if cfg := r.Core.Config(); cfg == nil || !cfg.Beans.RequireIfMatch {
+			return nil
+		}
+		etag := b.ETag()
+		return &etag
+	}
+	tx := r.Core.Begin()
+	defer tx.Rollback()
+	for i, b := range updated {
+		if err := tx.Core().Update(b, etagFor(matches[i])); err != nil {
+			return nil, fmt.Errorf("%s: %w", b.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// applyUpdateInput validates input and applies it to b in memory. It does
+// not persist the bean.
+func (r *CoreResolver) applyUpdateInput(b *bean.Bean, input model.UpdateBeanInput) error {
 	// Validate body and bodyMod are mutually exclusive
 	if input.Body != nil && input.BodyMod != nil {
-		return nil, fmt.Errorf("cannot specify both body and bodyMod")
+		return fmt.Errorf("cannot specify both body and bodyMod")
 	}
 
 	// Validate tags and addTags/removeTags are mutually exclusive
 	if input.Tags != nil && (input.AddTags != nil || input.RemoveTags != nil) {
-		return nil, fmt.Errorf("cannot specify both tags and addTags/removeTags")
+		return fmt.Errorf("cannot specify both tags and addTags/removeTags")
+	}
+
+	// Validate assignees and addAssignees/removeAssignees are mutually exclusive
+	if input.Assignees != nil && (input.AddAssignees != nil || input.RemoveAssignees != nil) {
+		return fmt.Errorf("cannot specify both assignees and addAssignees/removeAssignees")
+	}
+
+	// Validate status transition against the configured workflow
+	if input.Status != nil {
+		if err := r.Core.Config().ValidateTransition(b.Status, *input.Status); err != nil {
+			return err
+		}
 	}
 
 	// Update fields if provided
@@ -135,6 +246,21 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 	if input.Order != nil {
 		b.Order = *input.Order
 	}
+	if err := r.ValidateAndSetFields(b, input.Fields); err != nil {
+		return err
+	}
+	if err := ValidateAndSetDate(&b.StartAt, input.StartAt); err != nil {
+		return err
+	}
+	if err := ValidateAndSetDate(&b.DueAt, input.DueAt); err != nil {
+		return err
+	}
+	if input.Estimate != nil {
+		if *input.Estimate < 0 {
+			return fmt.Errorf("estimate cannot be negative")
+		}
+		b.Estimate = *input.Estimate
+	}
 	if input.Body != nil {
 		b.Body = *input.Body
 	} else if input.BodyMod != nil {
@@ -146,7 +272,7 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 			for i, replaceOp := range input.BodyMod.Replace {
 				newBody, err := bean.ReplaceOnce(workingBody, replaceOp.Old, replaceOp.New)
 				if err != nil {
-					return nil, fmt.Errorf("replacement %d failed: %w", i, err)
+					return fmt.Errorf("replacement %d failed: %w", i, err)
 				}
 				workingBody = newBody
 			}
@@ -191,17 +317,35 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 		b.Tags = newTags
 	}
 
+	// Handle assignees
+	if input.Assignees != nil {
+		b.Assignees = nil
+		for _, assignee := range input.Assignees {
+			if err := b.AddAssignee(assignee); err != nil {
+				return err
+			}
+		}
+	}
+	for _, assignee := range input.AddAssignees {
+		if err := b.AddAssignee(assignee); err != nil {
+			return err
+		}
+	}
+	for _, assignee := range input.RemoveAssignees {
+		b.RemoveAssignee(assignee)
+	}
+
 	// Handle parent relationship
 	if input.Parent != nil {
 		if err := r.ValidateAndSetParent(b, *input.Parent); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Handle blocking relationships
 	if input.AddBlocking != nil {
 		if err := r.ValidateAndAddBlocking(b, input.AddBlocking); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	if input.RemoveBlocking != nil {
@@ -211,23 +355,27 @@ func (r *CoreResolver) UpdateBean(ctx context.Context, id string, input model.Up
 	// Handle blocked-by relationships
 	if input.AddBlockedBy != nil {
 		if err := r.ValidateAndAddBlockedBy(b, input.AddBlockedBy); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	if input.RemoveBlockedBy != nil {
 		r.RemoveBlockedByRelationships(b, input.RemoveBlockedBy)
 	}
 
-	// ETag validation now happens inside Update() under write lock.
-	// If the bean is linked to a worktree, Core auto-routes the write there.
-	if err := r.Core.Update(b, input.IfMatch, opts...); err != nil {
-		return nil, err
+	// Handle typed links
+	if input.AddLinks != nil {
+		if err := r.ValidateAndAddLinks(b, input.AddLinks); err != nil {
+			return err
+		}
+	}
+	if input.RemoveLinks != nil {
+		r.RemoveLinks(b, input.RemoveLinks)
 	}
 
-	return b, nil
+	return nil
 }
 
-// DeleteBean removes a bean and its incoming links.
+// DeleteBean removes a bean and its incoming links in one transaction.
 func (r *CoreResolver) DeleteBean(ctx context.Context, id string) (bool, error) {
 	// Verify bean exists
 	_, err := r.Core.Get(id)
@@ -235,19 +383,39 @@ func (r *CoreResolver) DeleteBean(ctx context.Context, id string) (bool, error)
 		return false, err
 	}
 
+	tx := r.Core.Begin()
+	defer tx.Rollback()
+
 	// Remove incoming links first
-	if _, err := r.Core.RemoveLinksTo(id); err != nil {
+	if _, err := tx.Core().RemoveLinksTo(id); err != nil {
 		return false, err
 	}
 
 	// Delete the bean
-	if err := r.Core.Delete(id); err != nil {
+	if err := tx.Core().Delete(id); err != nil {
 		return false, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
+// MergeBean merges the duplicate bean id into the bean into.
+func (r *CoreResolver) MergeBean(ctx context.Context, id string, into string) (*model.MergeBeanResult, error) {
+	result, err := r.Core.Merge(id, into)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MergeBeanResult{
+		Into:           result.Into,
+		Duplicate:      result.Duplicate,
+		LinksRewritten: result.LinksRewritten,
+		Skipped:        result.Skipped,
+	}, nil
+}
+
 // SetParent sets or clears the parent of a bean.
 func (r *CoreResolver) SetParent(ctx context.Context, id string, parentID *string, ifMatch *string) (*bean.Bean, error) {
 	b, err := r.Core.Get(id)
@@ -379,6 +547,59 @@ func (r *CoreResolver) RemoveBlockedBy(ctx context.Context, id string, targetID
 	return b, nil
 }
 
+// AddLink adds a typed link.
+func (r *CoreResolver) AddLink(ctx context.Context, id string, linkType string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.ValidateAndAddLinks(b, []*model.LinkInput{{Type: linkType, TargetID: targetID}}); err != nil {
+		return nil, err
+	}
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// RemoveLink removes a typed link.
+func (r *CoreResolver) RemoveLink(ctx context.Context, id string, linkType string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.RemoveLinks(b, []*model.LinkInput{{Type: linkType, TargetID: targetID}})
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// AddComment appends a comment to a bean's thread. If author is not given,
+// the current user is recorded as the author.
+func (r *CoreResolver) AddComment(ctx context.Context, id string, text string, author *string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	commentAuthor := r.Core.CurrentUser()
+	if author != nil {
+		commentAuthor = *author
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if err := b.AddComment(commentAuthor, text, now); err != nil {
+		return nil, err
+	}
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
 // ArchiveBean archives a bean.
 func (r *CoreResolver) ArchiveBean(ctx context.Context, id string) (bool, error) {
 	if err := r.Core.Archive(id); err != nil {
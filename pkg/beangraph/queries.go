@@ -2,6 +2,7 @@ package beangraph
 
 import (
 	"context"
+	"sort"
 
 	"github.com/hmans/beans/internal/gitutil"
 	"github.com/hmans/beans/pkg/bean"
@@ -33,7 +34,10 @@ func (r *CoreResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]*
 		beans = r.Core.All()
 	}
 
-	result := ApplyFilter(beans, filter, r.Core)
+	result, err := ApplyFilter(beans, filter, r.Core)
+	if err != nil {
+		return nil, err
+	}
 
 	// Sort using the same logic as CLI and TUI
 	cfg := r.Core.Config()
@@ -42,6 +46,35 @@ func (r *CoreResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]*
 	return result, nil
 }
 
+// SearchBeans returns the beans matching a full-text search, best match
+// first, with their scores and highlighted fragments.
+func (r *CoreResolver) SearchBeans(ctx context.Context, query string, limit *int) ([]*model.SearchHit, error) {
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+	hits, err := r.Core.SearchHits(query, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.SearchHit, len(hits))
+	for i, hit := range hits {
+		highlights := make([]*model.SearchHighlight, 0, len(hit.Highlights))
+		for field, fragments := range hit.Highlights {
+			highlights = append(highlights, &model.SearchHighlight{Field: field, Fragments: fragments})
+		}
+		sort.Slice(highlights, func(a, b int) bool { return highlights[a].Field < highlights[b].Field })
+		result[i] = &model.SearchHit{Bean: hit.Bean, Score: hit.Score, Highlights: highlights}
+	}
+	return result, nil
+}
+
+// CurrentUser returns the identity used for assignment and "mine" filtering.
+func (r *CoreResolver) CurrentUser(ctx context.Context) (string, error) {
+	return r.Core.CurrentUser(), nil
+}
+
 // ProjectName returns the configured project name.
 func (r *CoreResolver) ProjectName(ctx context.Context) (string, error) {
 	cfg := r.Core.Config()
@@ -2,8 +2,10 @@ package beangraph
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
 	"github.com/hmans/beans/pkg/beancore"
 )
 
@@ -81,6 +83,37 @@ func (r *CoreResolver) ValidateAndSetParent(b *bean.Bean, parentID string) error
 	return nil
 }
 
+// ValidateAndSetFields validates custom field values against the fields
+// declared in the config and applies them. Empty values remove the field.
+func (r *CoreResolver) ValidateAndSetFields(b *bean.Bean, fields []*model.FieldInput) error {
+	for _, f := range fields {
+		value, err := r.Core.Config().NormalizeFieldValue(f.Name, f.Value)
+		if err != nil {
+			return err
+		}
+		b.SetField(f.Name, value)
+	}
+	return nil
+}
+
+// ValidateAndSetDate parses a start or due date input and stores it in dst.
+// A nil value leaves dst unchanged; an empty value clears it.
+func ValidateAndSetDate(dst **time.Time, value *string) error {
+	if value == nil {
+		return nil
+	}
+	if *value == "" {
+		*dst = nil
+		return nil
+	}
+	t, err := bean.ParseDate(*value)
+	if err != nil {
+		return err
+	}
+	*dst = &t
+	return nil
+}
+
 // ValidateAndAddBlocking validates and adds blocking relationships.
 func (r *CoreResolver) ValidateAndAddBlocking(b *bean.Bean, targetIDs []string) error {
 	for _, targetID := range targetIDs {
@@ -154,3 +187,26 @@ func (r *CoreResolver) RemoveBlockedByRelationships(b *bean.Bean, targetIDs []st
 		b.RemoveBlockedBy(normalizedTargetID)
 	}
 }
+
+// ValidateAndAddLinks validates and adds typed links.
+func (r *CoreResolver) ValidateAndAddLinks(b *bean.Bean, links []*model.LinkInput) error {
+	for _, link := range links {
+		// Normalise short ID to full ID
+		normalizedTargetID, _ := r.Core.NormalizeID(link.TargetID)
+
+		if err := r.Core.ValidateLink(b, link.Type, normalizedTargetID); err != nil {
+			return err
+		}
+
+		b.AddLink(link.Type, normalizedTargetID)
+	}
+	return nil
+}
+
+// RemoveLinks removes typed links.
+func (r *CoreResolver) RemoveLinks(b *bean.Bean, links []*model.LinkInput) {
+	for _, link := range links {
+		normalizedTargetID, _ := r.Core.NormalizeID(link.TargetID)
+		b.RemoveLink(link.Type, normalizedTargetID)
+	}
+}
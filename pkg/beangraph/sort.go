@@ -0,0 +1,115 @@
+package beangraph
+
+import (
+	"sort"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// SortBeans sorts beans in place by created, updated, due, status, priority
+// or id. Any other value sorts by status, priority, type and title, like the
+// TUI.
+func SortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) {
+	statusNames := cfg.StatusNames()
+	priorityNames := cfg.PriorityNames()
+	typeNames := cfg.TypeNames()
+
+	switch sortBy {
+	case "created":
+		sort.Slice(beans, func(i, j int) bool {
+			if beans[i].CreatedAt == nil && beans[j].CreatedAt == nil {
+				return beans[i].ID < beans[j].ID
+			}
+			if beans[i].CreatedAt == nil {
+				return false
+			}
+			if beans[j].CreatedAt == nil {
+				return true
+			}
+			return beans[i].CreatedAt.After(*beans[j].CreatedAt)
+		})
+	case "updated":
+		sort.Slice(beans, func(i, j int) bool {
+			if beans[i].UpdatedAt == nil && beans[j].UpdatedAt == nil {
+				return beans[i].ID < beans[j].ID
+			}
+			if beans[i].UpdatedAt == nil {
+				return false
+			}
+			if beans[j].UpdatedAt == nil {
+				return true
+			}
+			return beans[i].UpdatedAt.After(*beans[j].UpdatedAt)
+		})
+	case "due":
+		// Soonest due first; beans without a due date go last
+		sort.Slice(beans, func(i, j int) bool {
+			if beans[i].DueAt == nil && beans[j].DueAt == nil {
+				return beans[i].ID < beans[j].ID
+			}
+			if beans[i].DueAt == nil {
+				return false
+			}
+			if beans[j].DueAt == nil {
+				return true
+			}
+			if !beans[i].DueAt.Equal(*beans[j].DueAt) {
+				return beans[i].DueAt.Before(*beans[j].DueAt)
+			}
+			return beans[i].ID < beans[j].ID
+		})
+	case "status":
+		// Build status order from configured statuses
+		statusOrder := make(map[string]int)
+		for i, s := range statusNames {
+			statusOrder[s] = i
+		}
+		sort.Slice(beans, func(i, j int) bool {
+			oi, oj := statusOrder[beans[i].Status], statusOrder[beans[j].Status]
+			if oi != oj {
+				return oi < oj
+			}
+			return beans[i].ID < beans[j].ID
+		})
+	case "priority":
+		// Build priority order from configured priorities
+		priorityOrder := make(map[string]int)
+		for i, p := range priorityNames {
+			priorityOrder[p] = i
+		}
+		// Find normal priority index for beans without priority
+		normalIdx := len(priorityNames)
+		for i, p := range priorityNames {
+			if p == "normal" {
+				normalIdx = i
+				break
+			}
+		}
+		sort.Slice(beans, func(i, j int) bool {
+			pi := normalIdx
+			if beans[i].Priority != "" {
+				if order, ok := priorityOrder[beans[i].Priority]; ok {
+					pi = order
+				}
+			}
+			pj := normalIdx
+			if beans[j].Priority != "" {
+				if order, ok := priorityOrder[beans[j].Priority]; ok {
+					pj = order
+				}
+			}
+			if pi != pj {
+				return pi < pj
+			}
+			return beans[i].ID < beans[j].ID
+		})
+	case "id":
+		sort.Slice(beans, func(i, j int) bool {
+			return beans[i].ID < beans[j].ID
+		})
+	default:
+		// Default: sort by status order, then priority, then type order, then title (same as TUI)
+		bean.SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+	}
+}
@@ -0,0 +1,107 @@
+package beangraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beangraph/model"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// Views returns the named views declared in .beans.yml. A view without a
+// filter gets an empty one, since the schema's filter is non-null.
+func (r *CoreResolver) Views(ctx context.Context) ([]*config.ViewConfig, error) {
+	cfg := r.Core.Config()
+	if cfg == nil {
+		return []*config.ViewConfig{}, nil
+	}
+	views := make([]*config.ViewConfig, len(cfg.Views))
+	for i, v := range cfg.Views {
+		if v.Filter == nil {
+			v.Filter = map[string]any{}
+		}
+		views[i] = &v
+	}
+	return views, nil
+}
+
+// ViewBeans returns the beans matching a view, in the view's sort order.
+func (r *CoreResolver) ViewBeans(ctx context.Context, v *config.ViewConfig) ([]*bean.Bean, error) {
+	filter, err := r.ViewFilter(v)
+	if err != nil {
+		return nil, err
+	}
+	beans, err := r.Beans(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	SortBeans(beans, v.Sort, r.Core.Config())
+	return beans, nil
+}
+
+// ViewFilter converts a view's filter into a BeanFilter. Keys are the field
+// names of the GraphQL BeanFilter input; unknown keys are an error. Dates may
+// be given as YYYY-MM-DD, and "me" in assignee is the current user.
+func (r *CoreResolver) ViewFilter(v *config.ViewConfig) (*model.BeanFilter, error) {
+	raw := make(map[string]any, len(v.Filter))
+	for key, value := range v.Filter {
+		if s, ok := value.(string); ok && (key == "dueBefore" || key == "dueAfter") {
+			t, err := bean.ParseDate(s)
+			if err != nil {
+				return nil, fmt.Errorf("view %s: %s: %w", v.Name, key, err)
+			}
+			value = t.Format(time.RFC3339)
+		}
+		raw[key] = value
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("view %s: invalid filter: %w", v.Name, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	filter := &model.BeanFilter{}
+	if err := dec.Decode(filter); err != nil {
+		return nil, fmt.Errorf("view %s: invalid filter: %w", v.Name, err)
+	}
+
+	for i, a := range filter.Assignee {
+		if a == "me" {
+			filter.Assignee[i] = r.Core.CurrentUser()
+		}
+	}
+	return filter, nil
+}
+
+// MergeFilters returns base with every field that is set in override
+// replaced by override's value. Either may be nil.
+func MergeFilters(base, override *model.BeanFilter) (*model.BeanFilter, error) {
+	merged := make(map[string]json.RawMessage)
+	for _, f := range []*model.BeanFilter{base, override} {
+		if f == nil {
+			continue
+		}
+		data, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &merged); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	filter := &model.BeanFilter{}
+	if err := json.Unmarshal(data, filter); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
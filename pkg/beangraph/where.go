@@ -0,0 +1,486 @@
+package beangraph
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/pkg/bean"
+	"github.com/hmans/beans/pkg/beancore"
+	"github.com/hmans/beans/pkg/beanquery"
+	"github.com/hmans/beans/pkg/config"
+)
+
+// predicate reports whether a bean matches (part of) a where expression.
+type predicate func(b *bean.Bean) bool
+
+// whereKind determines how a field's values are compared.
+type whereKind int
+
+const (
+	whereText whereKind = iota
+	whereList
+	wherePriority
+	whereDate
+	whereNumber
+	whereBool
+)
+
+// whereField is a field a where expression can refer to. values returns the
+// field's values for a bean as strings, empty when unset: one value for
+// scalar fields, any number for lists.
+type whereField struct {
+	kind   whereKind
+	values func(b *bean.Bean) []string
+	// valid, if set, checks the values a field is compared with (e.g. that
+	// a status exists)
+	valid func(value string) bool
+}
+
+// whereFieldNames lists the built-in fields, for error messages.
+var whereFieldNames = []string{
+	"id", "slug", "title", "body", "status", "type", "priority", "tags", "assignees", "parent",
+	"source", "created", "updated", "start", "due", "estimate", "archived", "blocked", "overdue",
+}
+
+// relativeTimePattern matches relative times such as -7d, +2w or 12h.
+var relativeTimePattern = regexp.MustCompile(`^([+-]?)(\d+)([hdw])$`)
+
+// compileWhere parses a where expression and resolves its fields and values
+// against the project config, so that unknown fields, statuses and malformed
+// dates are reported before any bean is evaluated.
+func compileWhere(where string, core *beancore.Core, now time.Time) (predicate, error) {
+	expr, err := beanquery.Parse(where)
+	if err != nil {
+		return nil, err
+	}
+	c := &whereCompiler{core: core, cfg: core.Config(), now: now}
+	return c.compile(expr)
+}
+
+type whereCompiler struct {
+	core *beancore.Core
+	cfg  *config.Config
+	now  time.Time
+}
+
+func (c *whereCompiler) compile(expr beanquery.Expr) (predicate, error) {
+	switch e := expr.(type) {
+	case *beanquery.And:
+		left, right, err := c.compileBoth(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bean.Bean) bool { return left(b) && right(b) }, nil
+	case *beanquery.Or:
+		left, right, err := c.compileBoth(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bean.Bean) bool { return left(b) || right(b) }, nil
+	case *beanquery.Not:
+		inner, err := c.compile(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bean.Bean) bool { return !inner(b) }, nil
+	case *beanquery.Compare:
+		return c.compilePath(e.Path, e)
+	case *beanquery.Has:
+		return c.compilePath(e.Path, e)
+	default:
+		return nil, fmt.Errorf("unsupported expression %s", expr)
+	}
+}
+
+func (c *whereCompiler) compileBoth(left, right beanquery.Expr) (predicate, predicate, error) {
+	l, err := c.compile(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := c.compile(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+// compilePath compiles a comparison or presence check of the field at path.
+// All but the last element of path name relationships; the expression
+// matches if any related bean matches the rest of the path.
+func (c *whereCompiler) compilePath(path []string, expr beanquery.Expr) (predicate, error) {
+	if len(path) > 1 {
+		related := c.relation(path[0])
+		if related == nil {
+			return nil, fmt.Errorf("unknown relationship '%s' (must be parent, children, blocking, blocked_by or a link type)", path[0])
+		}
+		inner, err := c.compilePath(path[1:], expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bean.Bean) bool {
+			return slices.ContainsFunc(related(b), func(r *bean.Bean) bool { return inner(r) })
+		}, nil
+	}
+
+	name := path[0]
+	field := c.field(name)
+	if field == nil {
+		return nil, fmt.Errorf("unknown field '%s' (must be %s, a relationship, or a custom field)", name, strings.Join(whereFieldNames, ", "))
+	}
+
+	cmp, ok := expr.(*beanquery.Compare)
+	if !ok {
+		return func(b *bean.Bean) bool {
+			return slices.ContainsFunc(field.values(b), func(v string) bool { return v != "" })
+		}, nil
+	}
+	if field.valid != nil && cmp.Op != beanquery.OpMatch && cmp.Op != beanquery.OpNotMatch {
+		for _, v := range cmp.Values {
+			if !field.valid(v) {
+				return nil, fmt.Errorf("invalid %s '%s'", name, v)
+			}
+		}
+	}
+	match, err := c.comparison(name, field.kind, cmp.Op, cmp.Values)
+	if err != nil {
+		return nil, err
+	}
+	return func(b *bean.Bean) bool { return match(field.values(b)) }, nil
+}
+
+// comparison returns a function matching a field's values against the
+// values of a comparison. Lists match = and in if any element does, and !=
+// if none does. Unset dates and numbers match nothing.
+func (c *whereCompiler) comparison(name string, kind whereKind, op string, values []string) (func([]string) bool, error) {
+	if op == beanquery.OpMatch || op == beanquery.OpNotMatch {
+		if kind != whereText && kind != whereList {
+			return nil, fmt.Errorf("%s cannot be matched with %s", name, op)
+		}
+		needle := strings.ToLower(values[0])
+		contains := func(vs []string) bool {
+			return slices.ContainsFunc(vs, func(v string) bool { return strings.Contains(strings.ToLower(v), needle) })
+		}
+		if op == beanquery.OpNotMatch {
+			return func(vs []string) bool { return !contains(vs) }, nil
+		}
+		return contains, nil
+	}
+
+	switch kind {
+	case whereText, whereList, whereBool:
+		if kind == whereBool {
+			for _, v := range values {
+				if v != "true" && v != "false" {
+					return nil, fmt.Errorf("%s must be compared with true or false", name)
+				}
+			}
+		}
+		equalsAny := func(vs []string) bool {
+			if kind == whereBool && len(vs) == 0 {
+				vs = []string{"false"}
+			}
+			return slices.ContainsFunc(vs, func(v string) bool {
+				return slices.ContainsFunc(values, func(want string) bool { return strings.EqualFold(v, want) })
+			})
+		}
+		switch op {
+		case beanquery.OpEq, beanquery.OpIn:
+			return equalsAny, nil
+		case beanquery.OpNe:
+			return func(vs []string) bool { return !equalsAny(vs) }, nil
+		}
+		return nil, fmt.Errorf("%s cannot be compared with %s", name, op)
+
+	case wherePriority:
+		rank := func(p string) int {
+			if p == "" {
+				p = "normal"
+			}
+			return slices.Index(c.cfg.PriorityNames(), p)
+		}
+		// Ranks are negated so that more urgent compares greater, and
+		// "priority > normal" means high or critical
+		wants := make([]int, len(values))
+		for i, v := range values {
+			wants[i] = -rank(v)
+		}
+		return func(vs []string) bool {
+			if len(vs) == 0 {
+				return false
+			}
+			return compareOrdered(op, -rank(vs[0]), wants)
+		}, nil
+
+	case whereNumber:
+		wants := make([]float64, len(values))
+		for i, v := range values {
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be compared with a number, not '%s'", name, v)
+			}
+			wants[i] = n
+		}
+		return func(vs []string) bool {
+			if len(vs) == 0 || vs[0] == "" {
+				return false
+			}
+			n, err := strconv.ParseFloat(vs[0], 64)
+			return err == nil && compareOrdered(op, n, wants)
+		}, nil
+
+	case whereDate:
+		if op == beanquery.OpIn {
+			return nil, fmt.Errorf("%s cannot be compared with in", name)
+		}
+		from, to, err := c.parseTime(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return func(vs []string) bool {
+			if len(vs) == 0 || vs[0] == "" {
+				return false
+			}
+			t, err := bean.ParseDate(vs[0])
+			return err == nil && compareTime(op, t, from, to)
+		}, nil
+	}
+	return nil, fmt.Errorf("%s cannot be compared with %s", name, op)
+}
+
+// compareOrdered compares got with the wanted values: any of them for =
+// and in, the first for the other operators.
+func compareOrdered[T int | float64](op string, got T, wants []T) bool {
+	switch op {
+	case beanquery.OpEq, beanquery.OpIn:
+		return slices.Contains(wants, got)
+	case beanquery.OpNe:
+		return got != wants[0]
+	case beanquery.OpLt:
+		return got < wants[0]
+	case beanquery.OpLe:
+		return got <= wants[0]
+	case beanquery.OpGt:
+		return got > wants[0]
+	case beanquery.OpGe:
+		return got >= wants[0]
+	}
+	return false
+}
+
+// compareTime compares t with the span [from, to): a whole day for dates
+// given as YYYY-MM-DD or today, and a single instant otherwise.
+func compareTime(op string, t, from, to time.Time) bool {
+	switch op {
+	case beanquery.OpEq:
+		return !t.Before(from) && (t.Before(to) || t.Equal(to) && from.Equal(to))
+	case beanquery.OpNe:
+		return !compareTime(beanquery.OpEq, t, from, to)
+	case beanquery.OpLt:
+		return t.Before(from)
+	case beanquery.OpLe:
+		return t.Before(to) || t.Equal(to) && from.Equal(to)
+	case beanquery.OpGt:
+		return t.After(to) || !from.Equal(to) && t.Equal(to)
+	case beanquery.OpGe:
+		return !t.Before(from)
+	}
+	return false
+}
+
+// parseTime parses a date value: YYYY-MM-DD, an RFC 3339 timestamp, today,
+// now, or a time relative to now such as -7d, +2w or 12h.
+func (c *whereCompiler) parseTime(value string) (from, to time.Time, err error) {
+	switch strings.ToLower(value) {
+	case "now":
+		return c.now, c.now, nil
+	case "today":
+		y, m, d := c.now.UTC().Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return day, day.AddDate(0, 0, 1), nil
+	}
+	if m := relativeTimePattern.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		unit := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[m[3]]
+		d := time.Duration(n) * unit
+		if m[1] == "-" {
+			d = -d
+		}
+		t := c.now.Add(d)
+		return t, t, nil
+	}
+	t, err := bean.ParseDate(value)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date '%s' (use YYYY-MM-DD, RFC 3339, today, now, or a relative time like -7d)", value)
+	}
+	if len(strings.TrimSpace(value)) == len(bean.DateFormat) {
+		return t, t.AddDate(0, 0, 1), nil
+	}
+	return t, t, nil
+}
+
+// field resolves a field name to a built-in field, a relationship (compared
+// by the IDs of the related beans) or a declared custom field. Returns nil
+// for unknown names.
+func (c *whereCompiler) field(name string) *whereField {
+	text := func(get func(b *bean.Bean) string) func(b *bean.Bean) []string {
+		return func(b *bean.Bean) []string { return []string{get(b)} }
+	}
+	date := func(get func(b *bean.Bean) *time.Time) func(b *bean.Bean) []string {
+		return func(b *bean.Bean) []string {
+			if t := get(b); t != nil {
+				return []string{t.Format(time.RFC3339)}
+			}
+			return nil
+		}
+	}
+	boolean := func(get func(b *bean.Bean) bool) func(b *bean.Bean) []string {
+		return func(b *bean.Bean) []string {
+			if get(b) {
+				return []string{"true"}
+			}
+			return nil
+		}
+	}
+
+	switch name {
+	case "id":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.ID })}
+	case "slug":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Slug })}
+	case "title":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Title })}
+	case "body":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Body })}
+	case "status":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Status }), valid: c.cfg.IsValidStatus}
+	case "type":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Type }), valid: c.cfg.IsValidType}
+	case "priority":
+		return &whereField{kind: wherePriority, values: text(func(b *bean.Bean) string { return b.Priority }), valid: c.cfg.IsValidPriority}
+	case "parent":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Parent })}
+	case "source":
+		return &whereField{kind: whereText, values: text(func(b *bean.Bean) string { return b.Source })}
+	case "tags", "tag":
+		return &whereField{kind: whereList, values: func(b *bean.Bean) []string { return b.Tags }}
+	case "assignees", "assignee":
+		return &whereField{kind: whereList, values: func(b *bean.Bean) []string { return b.Assignees }}
+	case "created":
+		return &whereField{kind: whereDate, values: date(func(b *bean.Bean) *time.Time { return b.CreatedAt })}
+	case "updated":
+		return &whereField{kind: whereDate, values: date(func(b *bean.Bean) *time.Time { return b.UpdatedAt })}
+	case "start":
+		return &whereField{kind: whereDate, values: date(func(b *bean.Bean) *time.Time { return b.StartAt })}
+	case "due":
+		return &whereField{kind: whereDate, values: date(func(b *bean.Bean) *time.Time { return b.DueAt })}
+	case "estimate":
+		return &whereField{kind: whereNumber, values: func(b *bean.Bean) []string {
+			if b.Estimate == 0 {
+				return nil
+			}
+			return []string{strconv.FormatFloat(b.Estimate, 'f', -1, 64)}
+		}}
+	case "archived":
+		return &whereField{kind: whereBool, values: boolean(func(b *bean.Bean) bool { return c.core.IsArchived(b.ID) })}
+	case "blocked":
+		return &whereField{kind: whereBool, values: boolean(func(b *bean.Bean) bool { return c.core.IsBlocked(b.ID) })}
+	case "overdue":
+		return &whereField{kind: whereBool, values: boolean(func(b *bean.Bean) bool { return c.core.IsOverdue(b, c.now) })}
+	}
+
+	if related := c.relation(name); related != nil {
+		return &whereField{kind: whereList, values: func(b *bean.Bean) []string {
+			var ids []string
+			for _, r := range related(b) {
+				ids = append(ids, r.ID)
+			}
+			return ids
+		}}
+	}
+
+	if f := c.cfg.GetField(name); f != nil {
+		kind := whereText
+		switch f.Type {
+		case config.FieldTypeNumber:
+			kind = whereNumber
+		case config.FieldTypeDate:
+			kind = whereDate
+		}
+		return &whereField{kind: kind, values: text(func(b *bean.Bean) string { return b.Fields[name] })}
+	}
+	return nil
+}
+
+// relation resolves a relationship name to a function returning the related
+// beans: parent, children, blocking, blocked_by, or a link type or its
+// inverse name. Returns nil for unknown names.
+func (c *whereCompiler) relation(name string) func(b *bean.Bean) []*bean.Bean {
+	switch name {
+	case "parent":
+		return func(b *bean.Bean) []*bean.Bean {
+			if b.Parent == "" {
+				return nil
+			}
+			if parent, err := c.core.Get(b.Parent); err == nil {
+				return []*bean.Bean{parent}
+			}
+			return nil
+		}
+	case "children":
+		return func(b *bean.Bean) []*bean.Bean {
+			var children []*bean.Bean
+			for _, link := range c.core.FindIncomingLinks(b.ID) {
+				if link.LinkType == "parent" {
+					children = append(children, link.FromBean)
+				}
+			}
+			return children
+		}
+	case "blocking":
+		return func(b *bean.Bean) []*bean.Bean {
+			var targets []*bean.Bean
+			for _, id := range b.Blocking {
+				if target, err := c.core.Get(id); err == nil {
+					targets = append(targets, target)
+				}
+			}
+			return targets
+		}
+	case "blocked_by":
+		// Direct blockers from either side, like Bean.blockedBy
+		return func(b *bean.Bean) []*bean.Bean {
+			var blockers []*bean.Bean
+			seen := make(map[string]bool)
+			for _, id := range b.BlockedBy {
+				if blocker, err := c.core.Get(id); err == nil && !seen[blocker.ID] {
+					seen[blocker.ID] = true
+					blockers = append(blockers, blocker)
+				}
+			}
+			for _, link := range c.core.FindIncomingLinks(b.ID) {
+				if link.LinkType == "blocking" && !seen[link.FromBean.ID] {
+					seen[link.FromBean.ID] = true
+					blockers = append(blockers, link.FromBean)
+				}
+			}
+			return blockers
+		}
+	}
+
+	for _, lt := range c.cfg.LinkTypes() {
+		linkType := lt.Name
+		switch name {
+		case lt.Name:
+			return func(b *bean.Bean) []*bean.Bean { return c.core.FindLinkedBeans(b.ID, linkType) }
+		case lt.Inverse:
+			if lt.Inverse != "" {
+				return func(b *bean.Bean) []*bean.Bean { return c.core.FindLinkingBeans(b.ID, linkType) }
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+// Package beanquery parses bean query expressions such as
+// `(type = bug AND priority >= high) OR tags = security AND NOT archived`
+// into an AST. Evaluating the AST against beans is left to the caller, which
+// knows the fields and relationships a bean has.
+package beanquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Comparison operators.
+const (
+	OpEq       = "="
+	OpNe       = "!="
+	OpLt       = "<"
+	OpLe       = "<="
+	OpGt       = ">"
+	OpGe       = ">="
+	OpMatch    = "~"
+	OpNotMatch = "!~"
+	OpIn       = "in"
+)
+
+// Expr is a node of a parsed query.
+type Expr interface {
+	String() string
+}
+
+// And matches if both sides match.
+type And struct {
+	Left, Right Expr
+}
+
+// Or matches if either side matches.
+type Or struct {
+	Left, Right Expr
+}
+
+// Not matches if Expr does not.
+type Not struct {
+	Expr Expr
+}
+
+// Compare compares a field with one value, or with a list of values for OpIn.
+// Path is the field name split at dots; all but the last element name
+// relationships, as in parent.type.
+type Compare struct {
+	Path   []string
+	Op     string
+	Values []string
+}
+
+// Has matches if the field at Path is set (non-empty, non-zero or true).
+type Has struct {
+	Path []string
+}
+
+func (e *And) String() string { return "(" + e.Left.String() + " AND " + e.Right.String() + ")" }
+func (e *Or) String() string  { return "(" + e.Left.String() + " OR " + e.Right.String() + ")" }
+func (e *Not) String() string { return "NOT " + e.Expr.String() }
+func (e *Has) String() string { return strings.Join(e.Path, ".") }
+
+func (e *Compare) String() string {
+	values := make([]string, len(e.Values))
+	for i, v := range e.Values {
+		values[i] = quote(v)
+	}
+	if e.Op == OpIn {
+		return strings.Join(e.Path, ".") + " in (" + strings.Join(values, ", ") + ")"
+	}
+	return strings.Join(e.Path, ".") + " " + e.Op + " " + values[0]
+}
+
+// quote quotes v if it would not be read back as a single word.
+func quote(v string) string {
+	if v != "" && !strings.ContainsFunc(v, func(r rune) bool { return !isWordRune(r) }) && keyword(v) == "" {
+		return v
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+}
+
+// SyntaxError reports where a query could not be parsed. Pos is the byte
+// offset in the query.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Pos+1, e.Msg)
+}
+
+// Parse parses a query expression. NOT binds tighter than AND, which binds
+// tighter than OR; parentheses group. Keywords are case-insensitive, and
+// &&, || and ! may be used instead of AND, OR and NOT.
+func Parse(query string) (Expr, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s (expected AND or OR)", t)}
+	}
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokOp
+	tokAnd
+	tokOr
+	tokNot
+	tokIn
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+// isWordRune reports whether r can be part of an unquoted word: a field
+// name or a value such as 2025-06-01, -7d or user@example.com.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-.:+@/", r)
+}
+
+// keyword returns word in upper case if it is a keyword, and "" otherwise.
+func keyword(word string) string {
+	switch strings.ToLower(word) {
+	case "and", "or", "not", "in":
+		return strings.ToUpper(word)
+	}
+	return ""
+}
+
+func lex(query string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(query); {
+		r := rune(query[i])
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case strings.HasPrefix(query[i:], "&&"):
+			tokens = append(tokens, token{kind: tokAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(query[i:], "||"):
+			tokens = append(tokens, token{kind: tokOr, text: "||", pos: i})
+			i += 2
+		case strings.ContainsRune("=!<>~", r):
+			op := string(r)
+			if i+1 < len(query) && query[i+1] == '=' && r != '=' && r != '~' {
+				op += "="
+			} else if r == '!' && i+1 < len(query) && query[i+1] == '~' {
+				op = OpNotMatch
+			}
+			if op == "!" {
+				tokens = append(tokens, token{kind: tokNot, text: op, pos: i})
+			} else {
+				tokens = append(tokens, token{kind: tokOp, text: op, pos: i})
+			}
+			i += len(op)
+		case r == '"' || r == '\'':
+			var sb strings.Builder
+			start := i
+			i++
+			for ; i < len(query) && rune(query[i]) != r; i++ {
+				if query[i] == '\\' && i+1 < len(query) {
+					i++
+				}
+				sb.WriteByte(query[i])
+			}
+			if i >= len(query) {
+				return nil, &SyntaxError{Pos: start, Msg: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, token{kind: tokString, text: sb.String(), pos: start})
+		default:
+			start := i
+			for i < len(query) {
+				r, size := utf8.DecodeRuneInString(query[i:])
+				if !isWordRune(r) {
+					break
+				}
+				i += size
+			}
+			if i == start {
+				return nil, &SyntaxError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", query[i])}
+			}
+			word := query[start:i]
+			kind := tokWord
+			switch keyword(word) {
+			case "AND":
+				kind = tokAnd
+			case "OR":
+				kind = tokOr
+			case "NOT":
+				kind = tokNot
+			case "IN":
+				kind = tokIn
+			}
+			tokens = append(tokens, token{kind: kind, text: word, pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(query)}), nil
+}
+
+// pathPattern matches a field path: names of lowercase letters, numbers and
+// underscores, separated by dots.
+var pathPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)*$`)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokAnd {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().kind == tokNot {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.next()
+	if t.kind == tokLParen {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &SyntaxError{Pos: closing.pos, Msg: fmt.Sprintf("unexpected %s (expected ')')", closing)}
+		}
+		return expr, nil
+	}
+
+	if t.kind != tokWord {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s (expected a field name)", t)}
+	}
+	path := strings.ToLower(t.text)
+	if !pathPattern.MatchString(path) {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("invalid field name '%s'", t.text)}
+	}
+	fields := strings.Split(path, ".")
+
+	switch op := p.peek(); op.kind {
+	case tokOp:
+		p.next()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &Compare{Path: fields, Op: op.text, Values: []string{value}}, nil
+	case tokIn:
+		p.next()
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return &Compare{Path: fields, Op: OpIn, Values: values}, nil
+	case tokNot:
+		// field NOT IN (...)
+		if p.tokens[p.pos+1].kind == tokIn {
+			p.next()
+			p.next()
+			values, err := p.parseList()
+			if err != nil {
+				return nil, err
+			}
+			return &Not{Expr: &Compare{Path: fields, Op: OpIn, Values: values}}, nil
+		}
+	}
+	return &Has{Path: fields}, nil
+}
+
+func (p *parser) parseValue() (string, error) {
+	t := p.next()
+	if t.kind != tokWord && t.kind != tokString {
+		return "", &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s (expected a value)", t)}
+	}
+	return t.text, nil
+}
+
+func (p *parser) parseList() ([]string, error) {
+	if t := p.next(); t.kind != tokLParen {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s (expected '(' after in)", t)}
+	}
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		switch t := p.next(); t.kind {
+		case tokComma:
+			continue
+		case tokRParen:
+			return values, nil
+		default:
+			return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s (expected ',' or ')')", t)}
+		}
+	}
+}
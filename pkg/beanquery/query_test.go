@@ -0,0 +1,58 @@
+package beanquery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"type = bug", "type = bug"},
+		{"(type = bug AND priority >= high) OR (type = feature AND tags = security) AND NOT archived",
+			"((type = bug AND priority >= high) OR ((type = feature AND tags = security) AND NOT archived))"},
+		{"type=bug && !archived || blocked", "((type = bug AND NOT archived) OR blocked)"},
+		{"parent.type = epic", "parent.type = epic"},
+		{"status in (todo, draft)", "status in (todo, draft)"},
+		{"status not in (completed, scrapped)", "NOT status in (completed, scrapped)"},
+		{`title ~ "user login" and due < 2025-06-01`, `(title ~ "user login" AND due < 2025-06-01)`},
+		{"created > -7d and estimate <= 3", "(created > -7d AND estimate <= 3)"},
+		{"Assignee = me@example.com", "assignee = me@example.com"},
+		{"title !~ 'wip'", "title !~ wip"},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.query)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.query, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", "position 1: unexpected end of query (expected a field name)"},
+		{"type = bug status = todo", "position 12: unexpected 'status' (expected AND or OR)"},
+		{"(type = bug", "unexpected end of query (expected ')')"},
+		{"type =", "unexpected end of query (expected a value)"},
+		{`title ~ "open`, "position 9: unterminated string"},
+		{"status in todo", "expected '(' after in"},
+		{"status in (todo draft)", "expected ',' or ')'"},
+		{"type = bug AND", "expected a field name"},
+		{"$type = bug", "unexpected character '$'"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.query)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.query, err, tt.want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,8 +23,9 @@ const (
 	DefaultServerPort = 8080
 )
 
-// DefaultStatuses defines the hardcoded status configuration.
-// Statuses are not configurable - they are hardcoded like types.
+// DefaultStatuses defines the built-in status configuration.
+// Projects can add their own statuses (or restyle these) via the top-level
+// `statuses` key in .beans.yml; see Config.Statuses.
 // Order determines sort priority: in-progress first (active work), then todo, draft, and done states last.
 var DefaultStatuses = []StatusConfig{
 	{Name: "in-progress", Color: "yellow", Description: "Currently being worked on"},
@@ -33,15 +35,21 @@ var DefaultStatuses = []StatusConfig{
 	{Name: "scrapped", Color: "gray", Archive: true, Description: "Will not be done"},
 }
 
-// DefaultTypes defines the default type configuration.
+// DefaultTypes defines the built-in type configuration.
+// Projects can add their own types (or restyle these) via the top-level
+// `types` key in .beans.yml; see Config.Types.
 var DefaultTypes = []TypeConfig{
-	{Name: "milestone", Color: "cyan", Description: "A target release or checkpoint; group work that should ship together"},
-	{Name: "epic", Color: "purple", Description: "A thematic container for related work; should have child beans, not be worked on directly"},
-	{Name: "bug", Color: "red", Description: "Something that is broken and needs fixing"},
-	{Name: "feature", Color: "green", Description: "A user-facing capability or enhancement"},
-	{Name: "task", Color: "blue", Description: "A concrete piece of work to complete (eg. a chore, or a sub-task for a feature)"},
+	{Name: "milestone", Color: "cyan", Description: "A target release or checkpoint; group work that should ship together", Parents: []string{}},
+	{Name: "epic", Color: "purple", Description: "A thematic container for related work; should have child beans, not be worked on directly", Parents: []string{"milestone"}},
+	{Name: "bug", Color: "red", Description: "Something that is broken and needs fixing", Parents: []string{"milestone", "epic", "feature"}},
+	{Name: "feature", Color: "green", Description: "A user-facing capability or enhancement", Parents: []string{"milestone", "epic"}},
+	{Name: "task", Color: "blue", Description: "A concrete piece of work to complete (eg. a chore, or a sub-task for a feature)", Parents: []string{"milestone", "epic", "feature"}},
 }
 
+// DefaultParentTypes lists the parent types allowed for types that don't
+// declare their own parent rules.
+var DefaultParentTypes = []string{"milestone", "epic", "feature"}
+
 // DefaultPriorities defines the hardcoded priority configuration.
 // Priorities are ordered from highest to lowest urgency.
 var DefaultPriorities = []PriorityConfig{
@@ -60,13 +68,37 @@ type StatusConfig struct {
 	Description string `yaml:"description,omitempty"`
 }
 
-// TypeConfig defines a single bean type with its display color.
-type TypeConfig struct {
+// CustomStatusConfig declares a project-specific status in .beans.yml, or
+// overrides a built-in one. Archive is a pointer so that restyling a built-in
+// status without mentioning archive keeps its built-in archive flag.
+type CustomStatusConfig struct {
 	Name        string `yaml:"name"`
-	Color       string `yaml:"color"`
+	Color       string `yaml:"color,omitempty"`
+	Archive     *bool  `yaml:"archive,omitempty"`
 	Description string `yaml:"description,omitempty"`
 }
 
+// TypeConfig defines a single bean type with its display color and the
+// types its parent may have. An empty Parents list means beans of this type
+// cannot have a parent.
+type TypeConfig struct {
+	Name        string   `yaml:"name"`
+	Color       string   `yaml:"color"`
+	Description string   `yaml:"description,omitempty"`
+	Parents     []string `yaml:"parents,omitempty"`
+}
+
+// CustomTypeConfig declares a project-specific type in .beans.yml, or
+// overrides a built-in one. Parents is a pointer so that an omitted list
+// (use the built-in or default parent rules) can be told apart from an
+// empty one (no parent allowed).
+type CustomTypeConfig struct {
+	Name        string    `yaml:"name"`
+	Color       string    `yaml:"color,omitempty"`
+	Description string    `yaml:"description,omitempty"`
+	Parents     *[]string `yaml:"parents,omitempty"`
+}
+
 // PriorityConfig defines a single priority level with its display color.
 type PriorityConfig struct {
 	Name        string `yaml:"name"`
@@ -156,14 +188,75 @@ type ServerConfig struct {
 	CORSOrigins []string `yaml:"cors_origins,omitempty"`
 }
 
+// SearchConfig defines settings for full-text search.
+type SearchConfig struct {
+	// PersistentIndex keeps the search index on disk under .beans/.cache, so
+	// that each command only re-indexes the beans that changed since the last
+	// one instead of rebuilding the whole index in memory.
+	// Default: false
+	PersistentIndex bool `yaml:"persistent_index,omitempty"`
+
+	// EmbeddingCommand is a shell command that embeds bean texts for
+	// similarity search (`beans similar`), such as a script running a local
+	// model. It reads a JSON array of strings on stdin and writes a JSON array
+	// of vectors to stdout, and runs in the directory of .beans.yml.
+	// Default: "" (built-in LSA embeddings, computed offline from the beans)
+	EmbeddingCommand string `yaml:"embedding_command,omitempty"`
+
+	// DuplicateThreshold is the similarity (0 to 1) above which existing
+	// beans, open or archived, are reported as possible duplicates when a
+	// bean is created. `beans create` asks before creating such a bean.
+	// Default: 0 (no duplicate check)
+	DuplicateThreshold float64 `yaml:"duplicate_threshold,omitempty"`
+}
+
+// ForgeConfig defines settings for the git forge integration (pull request
+// status and creation in the web UI).
+type ForgeConfig struct {
+	// GiteaHosts lists self-hosted Gitea and Forgejo instances, by host
+	// ("git.example.org") or base URL ("http://git.example.org:3000").
+	// Projects whose origin remote is on one of them use the Gitea REST API.
+	// GitHub and GitLab remotes are detected without configuration.
+	// Default: []
+	GiteaHosts []string `yaml:"gitea_hosts,omitempty"`
+}
+
 // Config holds the beans configuration.
-// Note: Statuses are no longer stored in config - they are hardcoded like types.
 type Config struct {
 	Project  ProjectConfig  `yaml:"project,omitempty"`
 	Beans    BeansConfig    `yaml:"beans"`
 	Worktree WorktreeConfig `yaml:"worktree,omitempty"`
 	Agent    AgentConfig    `yaml:"agent,omitempty"`
 	Server   ServerConfig   `yaml:"server,omitempty"`
+	Search   SearchConfig   `yaml:"search,omitempty"`
+	Forge    ForgeConfig    `yaml:"forge,omitempty"`
+
+	// CustomStatuses declares project-specific statuses. Entries whose name
+	// matches a built-in status override the fields they set; new names are
+	// added to the built-in set.
+	CustomStatuses []CustomStatusConfig `yaml:"statuses,omitempty"`
+
+	// CustomTypes declares project-specific bean types. Entries whose name
+	// matches a built-in type override the fields they set; new names are
+	// added after the built-in types.
+	CustomTypes []CustomTypeConfig `yaml:"types,omitempty"`
+
+	// Transitions restricts which status changes are allowed. Each key is a
+	// source status and its value lists the statuses it may move to.
+	// Statuses without an entry may move to any status. When empty, all
+	// transitions are allowed.
+	Transitions map[string][]string `yaml:"transitions,omitempty"`
+
+	// Fields declares custom front-matter fields that beans may carry.
+	Fields []FieldConfig `yaml:"fields,omitempty"`
+
+	// CustomLinkTypes declares project-specific link types, added after the
+	// built-in relates_to, duplicates and caused_by.
+	CustomLinkTypes []LinkTypeConfig `yaml:"links,omitempty"`
+
+	// Views declares named views: saved filters with a sort order and
+	// columns, used by `beans list --view` and the TUI.
+	Views []ViewConfig `yaml:"views,omitempty"`
 
 	// configDir is the directory containing the config file (not serialized)
 	// Used to resolve relative paths
@@ -179,6 +272,11 @@ type BeansConfig struct {
 	DefaultStatus  string `yaml:"default_status,omitempty"`
 	DefaultType    string `yaml:"default_type,omitempty"`
 	RequireIfMatch bool   `yaml:"require_if_match,omitempty"`
+	// User is the identity used for assignment and "mine" filtering. It
+	// overrides git's user.email; the BEANS_USER env var overrides both.
+	User string `yaml:"user,omitempty"`
+	// EstimateUnit labels bean estimates (e.g. "points" or "hours").
+	EstimateUnit string `yaml:"estimate_unit,omitempty"`
 }
 
 // Default returns a Config with default values.
@@ -418,6 +516,18 @@ func (c *Config) toYAMLNode() *yaml.Node {
 		beansMapping.Content = append(beansMapping.Content, key, scalar("true", "!!bool"))
 	}
 
+	if c.Beans.User != "" {
+		key := strNode("user")
+		key.HeadComment = "Identity for assignees and --mine (overrides git user.email; BEANS_USER overrides this)"
+		beansMapping.Content = append(beansMapping.Content, key, strNode(c.Beans.User))
+	}
+
+	if c.Beans.EstimateUnit != "" {
+		key := strNode("estimate_unit")
+		key.HeadComment = "Unit for bean estimates (default: points)"
+		beansMapping.Content = append(beansMapping.Content, key, strNode(c.Beans.EstimateUnit))
+	}
+
 	// Build the worktree mapping
 	worktreeMapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
 	if c.Worktree.BaseRef != "" {
@@ -462,6 +572,35 @@ func (c *Config) toYAMLNode() *yaml.Node {
 		serverMapping.Content = append(serverMapping.Content, portKey, intNode(c.Server.Port))
 	}
 
+	// Build the search mapping
+	searchMapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if c.Search.PersistentIndex {
+		key := strNode("persistent_index")
+		key.HeadComment = "Keep the search index on disk (under .beans/.cache) and update it incrementally"
+		searchMapping.Content = append(searchMapping.Content, key, scalar("true", "!!bool"))
+	}
+	if c.Search.EmbeddingCommand != "" {
+		key := strNode("embedding_command")
+		key.HeadComment = "Command that embeds bean texts for similarity search (JSON strings on stdin, JSON vectors on stdout)"
+		searchMapping.Content = append(searchMapping.Content, key, strNode(c.Search.EmbeddingCommand))
+	}
+	if c.Search.DuplicateThreshold != 0 {
+		key := strNode("duplicate_threshold")
+		key.HeadComment = "Similarity (0 to 1) above which existing beans are reported as possible duplicates on create"
+		searchMapping.Content = append(searchMapping.Content, key, scalar(fmt.Sprintf("%g", c.Search.DuplicateThreshold), "!!float"))
+	}
+
+	// Build the forge mapping
+	forgeMapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if len(c.Forge.GiteaHosts) > 0 {
+		var hostsNode yaml.Node
+		if err := hostsNode.Encode(c.Forge.GiteaHosts); err == nil {
+			key := strNode("gitea_hosts")
+			key.HeadComment = "Self-hosted Gitea and Forgejo instances (hosts or base URLs)"
+			forgeMapping.Content = append(forgeMapping.Content, key, &hostsNode)
+		}
+	}
+
 	// Build the top-level mapping
 	topMapping := &yaml.Node{
 		Kind:        yaml.MappingNode,
@@ -486,6 +625,66 @@ func (c *Config) toYAMLNode() *yaml.Node {
 		topMapping.Content = append(topMapping.Content, strNode("server"), serverMapping)
 	}
 
+	if len(searchMapping.Content) > 0 {
+		topMapping.Content = append(topMapping.Content, strNode("search"), searchMapping)
+	}
+
+	if len(forgeMapping.Content) > 0 {
+		topMapping.Content = append(topMapping.Content, strNode("forge"), forgeMapping)
+	}
+
+	// Workflow customizations are only written when configured
+	if len(c.CustomStatuses) > 0 {
+		var statusesNode yaml.Node
+		if err := statusesNode.Encode(c.CustomStatuses); err == nil {
+			key := strNode("statuses")
+			key.HeadComment = "Custom statuses (added to, or overriding, the built-in ones)"
+			topMapping.Content = append(topMapping.Content, key, &statusesNode)
+		}
+	}
+	if len(c.CustomTypes) > 0 {
+		var typesNode yaml.Node
+		if err := typesNode.Encode(c.CustomTypes); err == nil {
+			key := strNode("types")
+			key.HeadComment = "Custom bean types (added to, or overriding, the built-in ones)"
+			topMapping.Content = append(topMapping.Content, key, &typesNode)
+		}
+	}
+	if len(c.Transitions) > 0 {
+		var transitionsNode yaml.Node
+		if err := transitionsNode.Encode(c.Transitions); err == nil {
+			key := strNode("transitions")
+			key.HeadComment = "Allowed status transitions (source status -> allowed target statuses)"
+			topMapping.Content = append(topMapping.Content, key, &transitionsNode)
+		}
+	}
+	if len(c.Fields) > 0 {
+		var fieldsNode yaml.Node
+		if err := fieldsNode.Encode(c.Fields); err == nil {
+			key := strNode("fields")
+			key.HeadComment = "Custom front matter fields (types: " + strings.Join(FieldTypes, ", ") + ")"
+			topMapping.Content = append(topMapping.Content, key, &fieldsNode)
+		}
+	}
+
+	if len(c.CustomLinkTypes) > 0 {
+		var linksNode yaml.Node
+		if err := linksNode.Encode(c.CustomLinkTypes); err == nil {
+			key := strNode("links")
+			key.HeadComment = "Custom link types (added to relates_to, duplicates and caused_by)"
+			topMapping.Content = append(topMapping.Content, key, &linksNode)
+		}
+	}
+
+	if len(c.Views) > 0 {
+		var viewsNode yaml.Node
+		if err := viewsNode.Encode(c.Views); err == nil {
+			key := strNode("views")
+			key.HeadComment = "Named views (filter, sort and columns), used by `beans list --view <name>`"
+			topMapping.Content = append(topMapping.Content, key, &viewsNode)
+		}
+	}
+
 	// Wrap in a document node
 	return &yaml.Node{
 		Kind:    yaml.DocumentNode,
@@ -493,47 +692,192 @@ func (c *Config) toYAMLNode() *yaml.Node {
 	}
 }
 
-// IsValidStatus returns true if the status is a valid hardcoded status.
-func (c *Config) IsValidStatus(status string) bool {
+// Statuses returns the effective status list: the built-in statuses with any
+// configured overrides applied, followed by custom statuses. Custom statuses
+// that don't archive are placed before the first archive status so that
+// done states keep sorting last.
+func (c *Config) Statuses() []StatusConfig {
+	statuses := make([]StatusConfig, len(DefaultStatuses))
+	copy(statuses, DefaultStatuses)
+	if c == nil || len(c.CustomStatuses) == 0 {
+		return statuses
+	}
+
+	var active, archived []StatusConfig
+	for _, custom := range c.CustomStatuses {
+		if custom.Name == "" {
+			continue
+		}
+		overridden := false
+		for i := range statuses {
+			if statuses[i].Name == custom.Name {
+				if custom.Color != "" {
+					statuses[i].Color = custom.Color
+				}
+				if custom.Description != "" {
+					statuses[i].Description = custom.Description
+				}
+				if custom.Archive != nil {
+					statuses[i].Archive = *custom.Archive
+				}
+				overridden = true
+				break
+			}
+		}
+		if overridden {
+			continue
+		}
+		status := StatusConfig{
+			Name:        custom.Name,
+			Color:       custom.Color,
+			Archive:     custom.Archive != nil && *custom.Archive,
+			Description: custom.Description,
+		}
+		if status.Color == "" {
+			status.Color = "gray"
+		}
+		if status.Archive {
+			archived = append(archived, status)
+		} else {
+			active = append(active, status)
+		}
+	}
+
+	// Insert active custom statuses before the first archive status
+	insertAt := len(statuses)
+	for i, s := range statuses {
+		if s.Archive {
+			insertAt = i
+			break
+		}
+	}
+	result := make([]StatusConfig, 0, len(statuses)+len(active)+len(archived))
+	result = append(result, statuses[:insertAt]...)
+	result = append(result, active...)
+	result = append(result, statuses[insertAt:]...)
+	result = append(result, archived...)
+	return result
+}
+
+// IsBuiltinStatus returns true if the name is one of DefaultStatuses.
+func IsBuiltinStatus(name string) bool {
 	for _, s := range DefaultStatuses {
-		if s.Name == status {
+		if s.Name == name {
 			return true
 		}
 	}
 	return false
 }
 
+// IsValidStatus returns true if the status is a built-in or configured status.
+func (c *Config) IsValidStatus(status string) bool {
+	return c.GetStatus(status) != nil
+}
+
 // StatusList returns a comma-separated list of valid statuses.
-// Statuses are hardcoded and not configurable.
 func (c *Config) StatusList() string {
-	names := make([]string, len(DefaultStatuses))
-	for i, s := range DefaultStatuses {
-		names[i] = s.Name
-	}
-	return strings.Join(names, ", ")
+	return strings.Join(c.StatusNames(), ", ")
 }
 
-// StatusNames returns a slice of valid status names.
-// Statuses are hardcoded and not configurable.
+// StatusNames returns a slice of valid status names in sort order.
 func (c *Config) StatusNames() []string {
-	names := make([]string, len(DefaultStatuses))
-	for i, s := range DefaultStatuses {
+	statuses := c.Statuses()
+	names := make([]string, len(statuses))
+	for i, s := range statuses {
 		names[i] = s.Name
 	}
 	return names
 }
 
 // GetStatus returns the StatusConfig for a given status name, or nil if not found.
-// Statuses are hardcoded and not configurable.
 func (c *Config) GetStatus(name string) *StatusConfig {
-	for i := range DefaultStatuses {
-		if DefaultStatuses[i].Name == name {
-			return &DefaultStatuses[i]
+	statuses := c.Statuses()
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
 		}
 	}
 	return nil
 }
 
+// AllowedTransitions returns the statuses a bean may move to from the given
+// status, in sort order. The current status is never included.
+func (c *Config) AllowedTransitions(from string) []string {
+	var result []string
+	for _, name := range c.StatusNames() {
+		if name != from && c.CanTransition(from, name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// CanTransition returns true if a bean may move from one status to another.
+// Keeping the same status and setting an initial status (from == "") are
+// always allowed, as are moves from statuses without a transitions entry.
+func (c *Config) CanTransition(from, to string) bool {
+	if from == "" || from == to || c == nil || len(c.Transitions) == 0 {
+		return true
+	}
+	allowed, ok := c.Transitions[from]
+	if !ok {
+		return true
+	}
+	for _, s := range allowed {
+		if s == to || s == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns an error describing why a status change is not
+// allowed, or nil if it is.
+func (c *Config) ValidateTransition(from, to string) error {
+	if c.CanTransition(from, to) {
+		return nil
+	}
+	allowed := c.AllowedTransitions(from)
+	if len(allowed) == 0 {
+		return fmt.Errorf("status transition from %q to %q is not allowed (%q is a final status)", from, to, from)
+	}
+	return fmt.Errorf("status transition from %q to %q is not allowed (allowed: %s)", from, to, strings.Join(allowed, ", "))
+}
+
+// ValidateWorkflow checks the configured statuses and transitions for
+// references to unknown statuses. Returns one message per problem found.
+func (c *Config) ValidateWorkflow() []string {
+	var problems []string
+	seen := make(map[string]bool)
+	for _, s := range c.CustomStatuses {
+		if s.Name == "" {
+			problems = append(problems, "custom status is missing a name")
+			continue
+		}
+		if seen[s.Name] {
+			problems = append(problems, fmt.Sprintf("status '%s' is declared more than once", s.Name))
+		}
+		seen[s.Name] = true
+	}
+
+	froms := make([]string, 0, len(c.Transitions))
+	for from := range c.Transitions {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+	for _, from := range froms {
+		if !c.IsValidStatus(from) {
+			problems = append(problems, fmt.Sprintf("transitions: unknown source status '%s'", from))
+		}
+		for _, to := range c.Transitions[from] {
+			if to != "*" && !c.IsValidStatus(to) {
+				problems = append(problems, fmt.Sprintf("transitions: '%s' lists unknown status '%s'", from, to))
+			}
+		}
+	}
+	return problems
+}
+
 // GetDefaultStatus returns the default status name for new beans.
 func (c *Config) GetDefaultStatus() string {
 	if c.Beans.DefaultStatus == "" {
@@ -547,8 +891,32 @@ func (c *Config) GetDefaultType() string {
 	return c.Beans.DefaultType
 }
 
+// DefaultEstimateUnit is the estimate unit used when none is configured.
+const DefaultEstimateUnit = "points"
+
+// GetEstimateUnit returns the configured estimate unit, defaulting to points.
+func (c *Config) GetEstimateUnit() string {
+	if c == nil || c.Beans.EstimateUnit == "" {
+		return DefaultEstimateUnit
+	}
+	return c.Beans.EstimateUnit
+}
+
+// DefaultDuplicateThreshold is the similarity above which beans are reported
+// as possible duplicates when a check is requested (e.g. with `beans create
+// --strict`) but search.duplicate_threshold is not set.
+const DefaultDuplicateThreshold = 0.8
+
+// GetDuplicateThreshold returns the configured duplicate threshold, or 0 if
+// new beans are not checked for duplicates.
+func (c *Config) GetDuplicateThreshold() float64 {
+	if c == nil {
+		return 0
+	}
+	return c.Search.DuplicateThreshold
+}
+
 // IsArchiveStatus returns true if the given status is marked for archiving.
-// Statuses are hardcoded and not configurable.
 func (c *Config) IsArchiveStatus(name string) bool {
 	if s := c.GetStatus(name); s != nil {
 		return s.Archive
@@ -556,44 +924,137 @@ func (c *Config) IsArchiveStatus(name string) bool {
 	return false
 }
 
+// Types returns the effective type list: the built-in types with any
+// configured overrides applied, followed by custom types in declaration order.
+func (c *Config) Types() []TypeConfig {
+	types := make([]TypeConfig, len(DefaultTypes))
+	copy(types, DefaultTypes)
+	if c == nil {
+		return types
+	}
+
+	for _, custom := range c.CustomTypes {
+		if custom.Name == "" {
+			continue
+		}
+		overridden := false
+		for i := range types {
+			if types[i].Name == custom.Name {
+				if custom.Color != "" {
+					types[i].Color = custom.Color
+				}
+				if custom.Description != "" {
+					types[i].Description = custom.Description
+				}
+				if custom.Parents != nil {
+					types[i].Parents = *custom.Parents
+				}
+				overridden = true
+				break
+			}
+		}
+		if overridden {
+			continue
+		}
+		t := TypeConfig{
+			Name:        custom.Name,
+			Color:       custom.Color,
+			Description: custom.Description,
+			Parents:     DefaultParentTypes,
+		}
+		if t.Color == "" {
+			t.Color = "gray"
+		}
+		if custom.Parents != nil {
+			t.Parents = *custom.Parents
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
 // GetType returns the TypeConfig for a given type name, or nil if not found.
-// Types are hardcoded and not configurable.
 func (c *Config) GetType(name string) *TypeConfig {
-	for i := range DefaultTypes {
-		if DefaultTypes[i].Name == name {
-			return &DefaultTypes[i]
+	types := c.Types()
+	for i := range types {
+		if types[i].Name == name {
+			return &types[i]
 		}
 	}
 	return nil
 }
 
 // TypeNames returns a slice of valid type names.
-// Types are hardcoded and not configurable.
 func (c *Config) TypeNames() []string {
-	names := make([]string, len(DefaultTypes))
-	for i, t := range DefaultTypes {
+	types := c.Types()
+	names := make([]string, len(types))
+	for i, t := range types {
 		names[i] = t.Name
 	}
 	return names
 }
 
-// IsValidType returns true if the type is a valid hardcoded type.
+// IsValidType returns true if the type is a built-in or configured type.
 func (c *Config) IsValidType(typeName string) bool {
+	return c.GetType(typeName) != nil
+}
+
+// TypeList returns a comma-separated list of valid types.
+func (c *Config) TypeList() string {
+	return strings.Join(c.TypeNames(), ", ")
+}
+
+// IsBuiltinType returns true if the name is one of DefaultTypes.
+func IsBuiltinType(name string) bool {
 	for _, t := range DefaultTypes {
-		if t.Name == typeName {
+		if t.Name == name {
 			return true
 		}
 	}
 	return false
 }
 
-// TypeList returns a comma-separated list of valid types.
-func (c *Config) TypeList() string {
-	names := make([]string, len(DefaultTypes))
-	for i, t := range DefaultTypes {
-		names[i] = t.Name
+// ValidParentTypes returns the types a bean of the given type may have as
+// its parent. Returns nil if beans of that type cannot have a parent.
+// Unknown types get DefaultParentTypes.
+func (c *Config) ValidParentTypes(typeName string) []string {
+	t := c.GetType(typeName)
+	if t == nil {
+		return DefaultParentTypes
 	}
-	return strings.Join(names, ", ")
+	if len(t.Parents) == 0 {
+		return nil
+	}
+	return t.Parents
+}
+
+// ValidateTypes checks the configured types for missing names, duplicates
+// and parent rules that reference unknown types. Returns one message per
+// problem found.
+func (c *Config) ValidateTypes() []string {
+	var problems []string
+	seen := make(map[string]bool)
+	for _, t := range c.CustomTypes {
+		if t.Name == "" {
+			problems = append(problems, "custom type is missing a name")
+			continue
+		}
+		if seen[t.Name] {
+			problems = append(problems, fmt.Sprintf("type '%s' is declared more than once", t.Name))
+		}
+		seen[t.Name] = true
+	}
+
+	for _, t := range c.Types() {
+		for _, parent := range t.Parents {
+			if !c.IsValidType(parent) {
+				problems = append(problems, fmt.Sprintf("type '%s' lists unknown parent type '%s'", t.Name, parent))
+			} else if parent == t.Name {
+				problems = append(problems, fmt.Sprintf("type '%s' cannot be its own parent type", t.Name))
+			}
+		}
+	}
+	return problems
 }
 
 // BeanColors holds resolved color information for rendering a bean
@@ -379,9 +379,8 @@ func TestGetType(t *testing.T) {
 	})
 }
 
-func TestTypesAreHardcoded(t *testing.T) {
-	// Types are hardcoded and not stored in config
-	// Verify that saving and loading a config doesn't affect types
+func TestTypesWithoutCustomTypes(t *testing.T) {
+	// Without custom types, saving and loading a config yields the built-in types
 
 	tmpDir := t.TempDir()
 
@@ -407,7 +406,7 @@ func TestTypesAreHardcoded(t *testing.T) {
 		t.Fatalf("Load() error = %v", err)
 	}
 
-	// Types should always come from DefaultTypes, not config
+	// Types should come from DefaultTypes
 	if len(loaded.TypeNames()) != 5 {
 		t.Errorf("len(TypeNames()) = %d, want 5", len(loaded.TypeNames()))
 	}
@@ -419,7 +418,7 @@ func TestTypesAreHardcoded(t *testing.T) {
 		}
 	}
 
-	// Statuses should also be hardcoded
+	// Statuses should also be the built-in ones
 	if len(loaded.StatusNames()) != 5 {
 		t.Errorf("len(StatusNames()) = %d, want 5", len(loaded.StatusNames()))
 	}
@@ -449,24 +448,21 @@ func TestTypeDescriptions(t *testing.T) {
 		}
 	})
 
-	t.Run("types in config file are ignored", func(t *testing.T) {
-		// Even if a config file has custom types, they should be ignored
-		// and hardcoded types should be used instead
+	t.Run("custom types in config file are added", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		configPath := filepath.Join(tmpDir, ConfigFileName)
 
-		// Config with custom types (should be ignored)
 		configYAML := `beans:
   prefix: "test-"
   id_length: 4
-  default_status: open
-statuses:
-  - name: open
-    color: green
 types:
-  - name: custom-type
+  - name: spike
     color: pink
-    description: "This should be ignored"
+    description: "Time-boxed research"
+  - name: incident
+    parents: []
+  - name: chore
+    parents: [epic]
 `
 		if err := os.WriteFile(configPath, []byte(configYAML), 0644); err != nil {
 			t.Fatalf("WriteFile error = %v", err)
@@ -477,18 +473,147 @@ types:
 			t.Fatalf("Load() error = %v", err)
 		}
 
-		// Custom type should not be valid
-		if loaded.IsValidType("custom-type") {
-			t.Error("IsValidType(\"custom-type\") = true, want false (custom types should be ignored)")
+		typ := loaded.GetType("spike")
+		if typ == nil {
+			t.Fatal("GetType(\"spike\") = nil, want non-nil")
+		}
+		if typ.Color != "pink" || typ.Description != "Time-boxed research" {
+			t.Errorf("GetType(\"spike\") = %+v, want configured color and description", typ)
+		}
+
+		// Parent rules: omitted means the defaults, empty means none
+		if got := strings.Join(loaded.ValidParentTypes("spike"), ","); got != "milestone,epic,feature" {
+			t.Errorf("ValidParentTypes(spike) = %s, want default parents", got)
+		}
+		if got := loaded.ValidParentTypes("incident"); got != nil {
+			t.Errorf("ValidParentTypes(incident) = %v, want nil", got)
+		}
+		if got := strings.Join(loaded.ValidParentTypes("chore"), ","); got != "epic" {
+			t.Errorf("ValidParentTypes(chore) = %s, want epic", got)
 		}
 
-		// Hardcoded types should still work
+		// Built-in types should still work
 		if !loaded.IsValidType("bug") {
 			t.Error("IsValidType(\"bug\") = false, want true")
 		}
 	})
 }
 
+func TestCustomTypes(t *testing.T) {
+	t.Run("custom types follow built-in types", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomTypes = []CustomTypeConfig{{Name: "spike"}, {Name: "chore", Color: "yellow"}}
+		want := "milestone, epic, bug, feature, task, spike, chore"
+		if got := cfg.TypeList(); got != want {
+			t.Errorf("TypeList() = %q, want %q", got, want)
+		}
+		if got := cfg.GetType("spike").Color; got != "gray" {
+			t.Errorf("spike color = %q, want gray", got)
+		}
+	})
+
+	t.Run("override keeps built-in parents unless set", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomTypes = []CustomTypeConfig{{Name: "epic", Color: "magenta"}}
+		if got := cfg.GetType("epic").Color; got != "magenta" {
+			t.Errorf("epic color = %q, want magenta", got)
+		}
+		if got := strings.Join(cfg.ValidParentTypes("epic"), ","); got != "milestone" {
+			t.Errorf("ValidParentTypes(epic) = %s, want milestone", got)
+		}
+		if len(cfg.TypeNames()) != len(DefaultTypes) {
+			t.Errorf("len(TypeNames()) = %d, want %d", len(cfg.TypeNames()), len(DefaultTypes))
+		}
+	})
+
+	t.Run("override can change built-in parents", func(t *testing.T) {
+		parents := []string{"milestone", "epic", "feature", "spike"}
+		cfg := Default()
+		cfg.CustomTypes = []CustomTypeConfig{{Name: "spike"}, {Name: "task", Parents: &parents}}
+		if got := strings.Join(cfg.ValidParentTypes("task"), ","); got != "milestone,epic,feature,spike" {
+			t.Errorf("ValidParentTypes(task) = %s", got)
+		}
+	})
+
+	t.Run("built-in parent rules", func(t *testing.T) {
+		cfg := Default()
+		tests := map[string]string{
+			"milestone": "",
+			"epic":      "milestone",
+			"feature":   "milestone,epic",
+			"task":      "milestone,epic,feature",
+			"bug":       "milestone,epic,feature",
+			"unknown":   "milestone,epic,feature",
+		}
+		for typeName, want := range tests {
+			if got := strings.Join(cfg.ValidParentTypes(typeName), ","); got != want {
+				t.Errorf("ValidParentTypes(%q) = %q, want %q", typeName, got, want)
+			}
+		}
+	})
+
+	t.Run("does not mutate DefaultTypes", func(t *testing.T) {
+		parents := []string{}
+		cfg := Default()
+		cfg.CustomTypes = []CustomTypeConfig{{Name: "epic", Color: "magenta", Parents: &parents}}
+		_ = cfg.Types()
+		if DefaultTypes[1].Color != "purple" || len(DefaultTypes[1].Parents) != 1 {
+			t.Errorf("DefaultTypes[1] = %+v, want unchanged", DefaultTypes[1])
+		}
+	})
+}
+
+func TestValidateTypes(t *testing.T) {
+	parents := []string{"epic", "nope"}
+	self := []string{"loop"}
+	cfg := Default()
+	cfg.CustomTypes = []CustomTypeConfig{
+		{Name: "spike", Parents: &parents},
+		{Name: "spike"},
+		{Name: "loop", Parents: &self},
+		{},
+	}
+
+	want := []string{
+		"type 'spike' is declared more than once",
+		"custom type is missing a name",
+		"type 'spike' lists unknown parent type 'nope'",
+		"type 'loop' cannot be its own parent type",
+	}
+	if got := cfg.ValidateTypes(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ValidateTypes() = %v, want %v", got, want)
+	}
+
+	if problems := Default().ValidateTypes(); len(problems) != 0 {
+		t.Errorf("ValidateTypes() on defaults = %v, want none", problems)
+	}
+}
+
+func TestLoadAndSaveCustomTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+	none := []string{}
+	cfg := Default()
+	cfg.CustomTypes = []CustomTypeConfig{
+		{Name: "spike", Color: "pink"},
+		{Name: "incident", Color: "red", Parents: &none},
+	}
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !loaded.IsValidType("spike") {
+		t.Error("IsValidType(\"spike\") = false after reload")
+	}
+	if got := loaded.ValidParentTypes("incident"); got != nil {
+		t.Errorf("ValidParentTypes(incident) = %v after reload, want nil", got)
+	}
+}
+
 func TestStatusDescriptions(t *testing.T) {
 	t.Run("hardcoded statuses have descriptions", func(t *testing.T) {
 		cfg := Default()
@@ -513,20 +638,17 @@ func TestStatusDescriptions(t *testing.T) {
 		}
 	})
 
-	t.Run("statuses in config file are ignored", func(t *testing.T) {
-		// Even if a config file has custom statuses, they should be ignored
-		// and hardcoded statuses should be used instead
+	t.Run("custom statuses in config file are added", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		configPath := filepath.Join(tmpDir, ConfigFileName)
 
-		// Config with custom statuses (should be ignored)
 		configYAML := `beans:
   prefix: "test-"
   id_length: 4
 statuses:
   - name: custom-status
     color: pink
-    description: "This should be ignored"
+    description: "A project-specific status"
 `
 		if err := os.WriteFile(configPath, []byte(configYAML), 0644); err != nil {
 			t.Fatalf("WriteFile error = %v", err)
@@ -537,18 +659,217 @@ statuses:
 			t.Fatalf("Load() error = %v", err)
 		}
 
-		// Custom status should not be valid
-		if loaded.IsValidStatus("custom-status") {
-			t.Error("IsValidStatus(\"custom-status\") = true, want false (custom statuses should be ignored)")
+		status := loaded.GetStatus("custom-status")
+		if status == nil {
+			t.Fatal("GetStatus(\"custom-status\") = nil, want non-nil")
+		}
+		if status.Description != "A project-specific status" {
+			t.Errorf("custom status description = %q", status.Description)
 		}
 
-		// Hardcoded statuses should still work
+		// Built-in statuses should still work
 		if !loaded.IsValidStatus("todo") {
 			t.Error("IsValidStatus(\"todo\") = false, want true")
 		}
 	})
 }
 
+func TestCustomStatuses(t *testing.T) {
+	t.Run("no custom statuses returns defaults", func(t *testing.T) {
+		cfg := Default()
+		got := cfg.StatusNames()
+		want := []string{"in-progress", "todo", "draft", "completed", "scrapped"}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("StatusNames() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("active statuses sort before archive statuses", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{
+			{Name: "in-review", Color: "magenta"},
+			{Name: "wontfix", Color: "gray", Archive: boolPtr(true)},
+			{Name: "blocked-external", Color: "red"},
+		}
+		got := cfg.StatusNames()
+		want := []string{"in-progress", "todo", "draft", "in-review", "blocked-external", "completed", "scrapped", "wontfix"}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("StatusNames() = %v, want %v", got, want)
+		}
+		if !cfg.IsArchiveStatus("wontfix") {
+			t.Error("IsArchiveStatus(\"wontfix\") = false, want true")
+		}
+	})
+
+	t.Run("custom entry overrides built-in status", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{
+			{Name: "todo", Color: "cyan", Description: "Ready for pickup"},
+		}
+		if len(cfg.StatusNames()) != 5 {
+			t.Errorf("len(StatusNames()) = %d, want 5", len(cfg.StatusNames()))
+		}
+		status := cfg.GetStatus("todo")
+		if status.Color != "cyan" || status.Description != "Ready for pickup" {
+			t.Errorf("GetStatus(\"todo\") = %+v, want overridden color and description", status)
+		}
+	})
+
+	t.Run("restyling a built-in status keeps its archive flag", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{{Name: "completed", Color: "cyan"}}
+		if !cfg.IsArchiveStatus("completed") {
+			t.Error("IsArchiveStatus(\"completed\") = false, want true")
+		}
+		if got := cfg.StatusNames(); got[len(got)-2] != "completed" {
+			t.Errorf("StatusNames() = %v, want completed to keep sorting with done states", got)
+		}
+	})
+
+	t.Run("archive can be turned off explicitly", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{{Name: "scrapped", Archive: boolPtr(false)}}
+		if cfg.IsArchiveStatus("scrapped") {
+			t.Error("IsArchiveStatus(\"scrapped\") = true, want false")
+		}
+	})
+
+	t.Run("custom status without color defaults to gray", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{{Name: "parked"}}
+		if got := cfg.GetStatus("parked").Color; got != "gray" {
+			t.Errorf("color = %q, want %q", got, "gray")
+		}
+	})
+
+	t.Run("does not mutate DefaultStatuses", func(t *testing.T) {
+		cfg := Default()
+		cfg.CustomStatuses = []CustomStatusConfig{{Name: "todo", Color: "cyan"}}
+		_ = cfg.Statuses()
+		if DefaultStatuses[1].Color != "green" {
+			t.Errorf("DefaultStatuses[1].Color = %q, want %q", DefaultStatuses[1].Color, "green")
+		}
+	})
+}
+
+func TestStatusTransitions(t *testing.T) {
+	cfg := Default()
+	cfg.CustomStatuses = []CustomStatusConfig{{Name: "in-review", Color: "magenta"}}
+	cfg.Transitions = map[string][]string{
+		"todo":        {"in-progress", "scrapped"},
+		"in-progress": {"in-review", "todo"},
+		"in-review":   {"completed", "in-progress"},
+		"completed":   {},
+	}
+
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"todo", "in-progress", true},
+		{"todo", "completed", false},
+		{"in-progress", "in-review", true},
+		{"in-progress", "completed", false},
+		{"in-review", "completed", true},
+		{"completed", "todo", false},
+		{"todo", "todo", true},       // unchanged status is always allowed
+		{"", "completed", true},      // initial status is always allowed
+		{"draft", "completed", true}, // no entry means unrestricted
+	}
+	for _, tt := range tests {
+		if got := cfg.CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	t.Run("wildcard allows everything", func(t *testing.T) {
+		c := Default()
+		c.Transitions = map[string][]string{"draft": {"*"}}
+		if !c.CanTransition("draft", "completed") {
+			t.Error("CanTransition(draft, completed) = false, want true")
+		}
+	})
+
+	t.Run("no transitions allows everything", func(t *testing.T) {
+		if !Default().CanTransition("completed", "draft") {
+			t.Error("CanTransition() = false, want true without configured transitions")
+		}
+	})
+
+	t.Run("ValidateTransition lists allowed targets", func(t *testing.T) {
+		err := cfg.ValidateTransition("todo", "completed")
+		if err == nil {
+			t.Fatal("ValidateTransition() = nil, want error")
+		}
+		if !strings.Contains(err.Error(), "allowed: in-progress, scrapped") {
+			t.Errorf("error = %q, want allowed targets listed", err)
+		}
+	})
+
+	t.Run("ValidateTransition reports final statuses", func(t *testing.T) {
+		err := cfg.ValidateTransition("completed", "todo")
+		if err == nil || !strings.Contains(err.Error(), "final status") {
+			t.Errorf("ValidateTransition() = %v, want final status error", err)
+		}
+	})
+
+	t.Run("AllowedTransitions follows status order", func(t *testing.T) {
+		got := cfg.AllowedTransitions("in-progress")
+		want := []string{"todo", "in-review"}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("AllowedTransitions() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestValidateWorkflow(t *testing.T) {
+	cfg := Default()
+	cfg.CustomStatuses = []CustomStatusConfig{
+		{Name: "in-review"},
+		{Name: "in-review"},
+	}
+	cfg.Transitions = map[string][]string{
+		"todo":    {"in-review", "bogus"},
+		"unknown": {"todo"},
+	}
+
+	problems := cfg.ValidateWorkflow()
+	want := []string{
+		"status 'in-review' is declared more than once",
+		"transitions: 'todo' lists unknown status 'bogus'",
+		"transitions: unknown source status 'unknown'",
+	}
+	if strings.Join(problems, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ValidateWorkflow() = %v, want %v", problems, want)
+	}
+
+	if problems := Default().ValidateWorkflow(); len(problems) != 0 {
+		t.Errorf("ValidateWorkflow() on defaults = %v, want none", problems)
+	}
+}
+
+func TestLoadAndSaveWorkflow(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := Default()
+	cfg.CustomStatuses = []CustomStatusConfig{{Name: "in-review", Color: "magenta", Description: "Awaiting review"}}
+	cfg.Transitions = map[string][]string{"in-review": {"completed", "in-progress"}}
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if s := loaded.GetStatus("in-review"); s == nil || s.Description != "Awaiting review" {
+		t.Errorf("GetStatus(\"in-review\") = %+v, want custom status", s)
+	}
+	if loaded.CanTransition("in-review", "todo") {
+		t.Error("CanTransition(in-review, todo) = true, want false after reload")
+	}
+}
+
 func TestFindConfig(t *testing.T) {
 	t.Run("finds config in current directory", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -1009,6 +1330,72 @@ func TestSaveIncludesAgentSection(t *testing.T) {
 	}
 }
 
+func TestSaveIncludesSearchSection(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := DefaultWithPrefix("test-")
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("ReadFile error = %v", err)
+	}
+	if strings.Contains(string(data), "search:") {
+		t.Error("expected search section to be omitted when not configured")
+	}
+
+	cfg.Search.PersistentIndex = true
+	cfg.Search.EmbeddingCommand = "./embed.sh"
+	cfg.Search.DuplicateThreshold = 0.85
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !loaded.Search.PersistentIndex {
+		t.Error("expected search.persistent_index to survive a save and load")
+	}
+	if loaded.Search.EmbeddingCommand != "./embed.sh" {
+		t.Errorf("search.embedding_command = %q, want %q", loaded.Search.EmbeddingCommand, "./embed.sh")
+	}
+	if got := loaded.GetDuplicateThreshold(); got != 0.85 {
+		t.Errorf("GetDuplicateThreshold() = %v, want 0.85", got)
+	}
+}
+
+func TestSaveIncludesForgeSection(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := DefaultWithPrefix("test-")
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("ReadFile error = %v", err)
+	}
+	if strings.Contains(string(data), "forge:") {
+		t.Error("expected forge section to be omitted when not configured")
+	}
+
+	cfg.Forge.GiteaHosts = []string{"git.example.org", "http://forgejo.lan:3000"}
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Forge.GiteaHosts) != 2 || loaded.Forge.GiteaHosts[1] != "http://forgejo.lan:3000" {
+		t.Errorf("forge.gitea_hosts = %q, want both hosts", loaded.Forge.GiteaHosts)
+	}
+}
+
 func TestSaveOmitsEmptyAgentSection(t *testing.T) {
 	tmpDir := t.TempDir()
 
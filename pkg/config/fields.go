@@ -0,0 +1,148 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Custom field types that can be declared in .beans.yml.
+const (
+	FieldTypeString = "string"
+	FieldTypeNumber = "number"
+	FieldTypeDate   = "date"
+	FieldTypeEnum   = "enum"
+	FieldTypeUser   = "user"
+	FieldTypeURL    = "url"
+)
+
+// FieldTypes lists the supported custom field types.
+var FieldTypes = []string{FieldTypeString, FieldTypeNumber, FieldTypeDate, FieldTypeEnum, FieldTypeUser, FieldTypeURL}
+
+// FieldDateFormat is the layout used for date field values.
+const FieldDateFormat = "2006-01-02"
+
+// ReservedFieldNames are the built-in front-matter keys, which custom fields
+// cannot use.
+var ReservedFieldNames = []string{
+	"title", "status", "type", "priority", "tags", "assignees", "created_at", "updated_at",
+	"start_at", "due_at", "estimate", "order", "parent", "blocking", "blocked_by", "links", "comments",
+}
+
+// fieldNamePattern matches valid custom field names: lowercase letters,
+// numbers and underscores, starting with a letter.
+var fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// FieldConfig declares a custom front-matter field.
+type FieldConfig struct {
+	Name        string   `yaml:"name"`
+	Type        string   `yaml:"type"`
+	Values      []string `yaml:"values,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+}
+
+// GetField returns the FieldConfig for a given field name, or nil if not declared.
+func (c *Config) GetField(name string) *FieldConfig {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Fields {
+		if c.Fields[i].Name == name {
+			return &c.Fields[i]
+		}
+	}
+	return nil
+}
+
+// FieldNames returns the names of all declared custom fields.
+func (c *Config) FieldNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, len(c.Fields))
+	for i, f := range c.Fields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// NormalizeFieldValue validates a value for the named custom field and
+// returns it in canonical form (trimmed, numbers without redundant digits,
+// dates as YYYY-MM-DD). An empty value is returned unchanged, since it
+// clears the field.
+func (c *Config) NormalizeFieldValue(name, value string) (string, error) {
+	field := c.GetField(name)
+	if field == nil {
+		if len(c.FieldNames()) == 0 {
+			return "", fmt.Errorf("unknown field: %s (no custom fields are declared in .beans.yml)", name)
+		}
+		return "", fmt.Errorf("unknown field: %s (must be %s)", name, strings.Join(c.FieldNames(), ", "))
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	switch field.Type {
+	case FieldTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for %s: %q is not a number", name, value)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case FieldTypeDate:
+		d, err := time.Parse(FieldDateFormat, value)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for %s: %q is not a date (use YYYY-MM-DD)", name, value)
+		}
+		return d.Format(FieldDateFormat), nil
+	case FieldTypeEnum:
+		if !slices.Contains(field.Values, value) {
+			return "", fmt.Errorf("invalid value for %s: %s (must be %s)", name, value, strings.Join(field.Values, ", "))
+		}
+	case FieldTypeUser:
+		if strings.ContainsAny(value, " \t\n") {
+			return "", fmt.Errorf("invalid value for %s: %q is not a user name or email", name, value)
+		}
+	case FieldTypeURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", fmt.Errorf("invalid value for %s: %q is not an http(s) URL", name, value)
+		}
+	}
+	return value, nil
+}
+
+// ValidateFields checks the declared custom fields for invalid or reserved
+// names, duplicates, unknown types and enums without values. Returns one
+// message per problem found.
+func (c *Config) ValidateFields() []string {
+	var problems []string
+	seen := make(map[string]bool)
+	for _, f := range c.Fields {
+		switch {
+		case f.Name == "":
+			problems = append(problems, "custom field is missing a name")
+			continue
+		case !fieldNamePattern.MatchString(f.Name):
+			problems = append(problems, fmt.Sprintf("field '%s' has an invalid name (use lowercase letters, numbers and underscores)", f.Name))
+		case slices.Contains(ReservedFieldNames, f.Name):
+			problems = append(problems, fmt.Sprintf("field '%s' clashes with a built-in front matter key", f.Name))
+		case seen[f.Name]:
+			problems = append(problems, fmt.Sprintf("field '%s' is declared more than once", f.Name))
+		}
+		seen[f.Name] = true
+
+		if !slices.Contains(FieldTypes, f.Type) {
+			problems = append(problems, fmt.Sprintf("field '%s' has unknown type '%s' (must be %s)", f.Name, f.Type, strings.Join(FieldTypes, ", ")))
+		} else if f.Type == FieldTypeEnum && len(f.Values) == 0 {
+			problems = append(problems, fmt.Sprintf("enum field '%s' has no values", f.Name))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testFieldsConfig() *Config {
+	cfg := Default()
+	cfg.Fields = []FieldConfig{
+		{Name: "team", Type: FieldTypeString},
+		{Name: "points", Type: FieldTypeNumber},
+		{Name: "deadline", Type: FieldTypeDate},
+		{Name: "severity", Type: FieldTypeEnum, Values: []string{"low", "high"}},
+		{Name: "owner", Type: FieldTypeUser},
+		{Name: "ticket", Type: FieldTypeURL},
+	}
+	return cfg
+}
+
+func TestNormalizeFieldValue(t *testing.T) {
+	cfg := testFieldsConfig()
+
+	tests := []struct {
+		name    string
+		field   string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{"string is trimmed", "team", "  platform ", "platform", ""},
+		{"empty clears", "team", "", "", ""},
+		{"integer", "points", "3", "3", ""},
+		{"decimal is canonicalized", "points", "2.50", "2.5", ""},
+		{"not a number", "points", "lots", "", "not a number"},
+		{"date", "deadline", "2024-03-01", "2024-03-01", ""},
+		{"bad date", "deadline", "03/01/2024", "", "not a date"},
+		{"enum value", "severity", "high", "high", ""},
+		{"unknown enum value", "severity", "medium", "", "must be low, high"},
+		{"user", "owner", "alice@example.com", "alice@example.com", ""},
+		{"user with spaces", "owner", "alice smith", "", "not a user name"},
+		{"url", "ticket", "https://example.com/T-1", "https://example.com/T-1", ""},
+		{"url without scheme", "ticket", "example.com/T-1", "", "not an http(s) URL"},
+		{"undeclared field", "color", "red", "", "unknown field: color"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cfg.NormalizeFieldValue(tt.field, tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NormalizeFieldValue() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeFieldValue() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeFieldValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	if problems := testFieldsConfig().ValidateFields(); len(problems) != 0 {
+		t.Errorf("ValidateFields() = %v, want none", problems)
+	}
+
+	cfg := Default()
+	cfg.Fields = []FieldConfig{
+		{Type: FieldTypeString},
+		{Name: "Team", Type: FieldTypeString},
+		{Name: "status", Type: FieldTypeString},
+		{Name: "points", Type: FieldTypeNumber},
+		{Name: "points", Type: FieldTypeNumber},
+		{Name: "mood", Type: "feeling"},
+		{Name: "size", Type: FieldTypeEnum},
+	}
+	problems := cfg.ValidateFields()
+
+	want := []string{
+		"custom field is missing a name",
+		"field 'Team' has an invalid name",
+		"field 'status' clashes with a built-in front matter key",
+		"field 'points' is declared more than once",
+		"field 'mood' has unknown type 'feeling'",
+		"enum field 'size' has no values",
+	}
+	if len(problems) != len(want) {
+		t.Fatalf("ValidateFields() = %v, want %d problems", problems, len(want))
+	}
+	for i, w := range want {
+		if !strings.HasPrefix(problems[i], w) {
+			t.Errorf("problem %d = %q, want prefix %q", i, problems[i], w)
+		}
+	}
+}
+
+func TestLoadAndSaveFields(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := testFieldsConfig()
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Fields) != len(cfg.Fields) {
+		t.Fatalf("loaded %d fields, want %d", len(loaded.Fields), len(cfg.Fields))
+	}
+	severity := loaded.GetField("severity")
+	if severity == nil || severity.Type != FieldTypeEnum || len(severity.Values) != 2 {
+		t.Errorf("GetField(severity) = %+v after reload", severity)
+	}
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Built-in link type names.
+const (
+	LinkRelatesTo  = "relates_to"
+	LinkDuplicates = "duplicates"
+	LinkCausedBy   = "caused_by"
+)
+
+// LinkTypeConfig declares a typed relationship between beans. Links are
+// stored in the bean's front matter under links, keyed by type name.
+type LinkTypeConfig struct {
+	Name string `yaml:"name"`
+	// Inverse names the relationship seen from the target bean (e.g.
+	// "duplicated_by" for "duplicates"). Unused for symmetric types.
+	Inverse string `yaml:"inverse,omitempty"`
+	// Symmetric link types mean the same in both directions, so a link
+	// from A to B also relates B to A.
+	Symmetric bool `yaml:"symmetric,omitempty"`
+	// Acyclic link types are checked for cycles, like parent and blocking.
+	Acyclic     bool   `yaml:"acyclic,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// DefaultLinkTypes are the built-in link types. Projects can add their own
+// under links in .beans.yml.
+var DefaultLinkTypes = []LinkTypeConfig{
+	{Name: LinkRelatesTo, Symmetric: true, Description: "Related work worth looking at together"},
+	{Name: LinkDuplicates, Inverse: "duplicated_by", Acyclic: true, Description: "This bean duplicates the target, which is the one to keep"},
+	{Name: LinkCausedBy, Inverse: "causes", Acyclic: true, Description: "This bean was caused by the target (e.g. a bug introduced by a feature)"},
+}
+
+// LinkTypes returns the built-in link types followed by the custom ones
+// declared in .beans.yml.
+func (c *Config) LinkTypes() []LinkTypeConfig {
+	types := slices.Clone(DefaultLinkTypes)
+	if c == nil {
+		return types
+	}
+	for _, custom := range c.CustomLinkTypes {
+		if custom.Name != "" && !IsBuiltinLinkType(custom.Name) {
+			types = append(types, custom)
+		}
+	}
+	return types
+}
+
+// GetLinkType returns the LinkTypeConfig for a given link type name, or nil
+// if not found.
+func (c *Config) GetLinkType(name string) *LinkTypeConfig {
+	types := c.LinkTypes()
+	for i := range types {
+		if types[i].Name == name {
+			return &types[i]
+		}
+	}
+	return nil
+}
+
+// LinkTypeNames returns the names of all link types.
+func (c *Config) LinkTypeNames() []string {
+	types := c.LinkTypes()
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// ValidateLinkType returns an error if name is not a known link type.
+func (c *Config) ValidateLinkType(name string) error {
+	if c.GetLinkType(name) == nil {
+		return fmt.Errorf("unknown link type: %s (must be %s)", name, strings.Join(c.LinkTypeNames(), ", "))
+	}
+	return nil
+}
+
+// IsBuiltinLinkType returns true if the name is one of DefaultLinkTypes.
+func IsBuiltinLinkType(name string) bool {
+	for _, t := range DefaultLinkTypes {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateLinkTypes checks the custom link types for missing or invalid
+// names, clashes with built-in types and inverse names, and duplicates.
+// Returns one message per problem found.
+func (c *Config) ValidateLinkTypes() []string {
+	var problems []string
+	taken := make(map[string]bool)
+	for _, t := range DefaultLinkTypes {
+		taken[t.Name] = true
+		if t.Inverse != "" {
+			taken[t.Inverse] = true
+		}
+	}
+
+	for _, t := range c.CustomLinkTypes {
+		switch {
+		case t.Name == "":
+			problems = append(problems, "custom link type is missing a name")
+			continue
+		case !fieldNamePattern.MatchString(t.Name):
+			problems = append(problems, fmt.Sprintf("link type '%s' has an invalid name (use lowercase letters, numbers and underscores)", t.Name))
+		case IsBuiltinLinkType(t.Name):
+			problems = append(problems, fmt.Sprintf("link type '%s' is built in and cannot be redeclared", t.Name))
+		case taken[t.Name]:
+			problems = append(problems, fmt.Sprintf("link type '%s' is declared more than once or clashes with an inverse name", t.Name))
+		}
+		taken[t.Name] = true
+
+		if t.Symmetric && t.Inverse != "" {
+			problems = append(problems, fmt.Sprintf("symmetric link type '%s' cannot have an inverse name", t.Name))
+		} else if t.Inverse != "" {
+			if !fieldNamePattern.MatchString(t.Inverse) {
+				problems = append(problems, fmt.Sprintf("link type '%s' has an invalid inverse name '%s'", t.Name, t.Inverse))
+			} else if taken[t.Inverse] {
+				problems = append(problems, fmt.Sprintf("inverse name '%s' of link type '%s' is already in use", t.Inverse, t.Name))
+			}
+			taken[t.Inverse] = true
+		}
+	}
+	return problems
+}
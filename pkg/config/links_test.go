@@ -0,0 +1,78 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLinkTypes(t *testing.T) {
+	cfg := Default()
+	cfg.CustomLinkTypes = []LinkTypeConfig{
+		{Name: "follows", Inverse: "followed_by", Acyclic: true},
+	}
+
+	want := []string{LinkRelatesTo, LinkDuplicates, LinkCausedBy, "follows"}
+	if got := cfg.LinkTypeNames(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("LinkTypeNames() = %v, want %v", got, want)
+	}
+	if lt := cfg.GetLinkType(LinkRelatesTo); lt == nil || !lt.Symmetric {
+		t.Errorf("GetLinkType(relates_to) = %+v, want symmetric", lt)
+	}
+	if lt := cfg.GetLinkType("follows"); lt == nil || lt.Inverse != "followed_by" || !lt.Acyclic {
+		t.Errorf("GetLinkType(follows) = %+v", lt)
+	}
+	if err := cfg.ValidateLinkType("blocks"); err == nil || !strings.Contains(err.Error(), "unknown link type") {
+		t.Errorf("ValidateLinkType(blocks) error = %v, want unknown link type", err)
+	}
+}
+
+func TestValidateLinkTypes(t *testing.T) {
+	cfg := Default()
+	cfg.CustomLinkTypes = []LinkTypeConfig{
+		{Inverse: "x"},
+		{Name: "Follows"},
+		{Name: "duplicates"},
+		{Name: "causes"},
+		{Name: "mirrors", Symmetric: true, Inverse: "mirrored_by"},
+		{Name: "tracks", Inverse: "duplicated_by"},
+		{Name: "tracks"},
+	}
+	problems := cfg.ValidateLinkTypes()
+
+	want := []string{
+		"custom link type is missing a name",
+		"link type 'Follows' has an invalid name",
+		"link type 'duplicates' is built in",
+		"link type 'causes' is declared more than once or clashes with an inverse name",
+		"symmetric link type 'mirrors' cannot have an inverse name",
+		"inverse name 'duplicated_by' of link type 'tracks' is already in use",
+		"link type 'tracks' is declared more than once",
+	}
+	if len(problems) != len(want) {
+		t.Fatalf("ValidateLinkTypes() = %v, want %d problems", problems, len(want))
+	}
+	for i, w := range want {
+		if !strings.HasPrefix(problems[i], w) {
+			t.Errorf("problem %d = %q, want prefix %q", i, problems[i], w)
+		}
+	}
+}
+
+func TestLoadAndSaveLinkTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := Default()
+	cfg.CustomLinkTypes = []LinkTypeConfig{{Name: "follows", Inverse: "followed_by"}}
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if lt := loaded.GetLinkType("follows"); lt == nil || lt.Inverse != "followed_by" {
+		t.Errorf("GetLinkType(follows) = %+v after reload", lt)
+	}
+}
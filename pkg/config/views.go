@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ViewSorts lists the sort orders a view can use. An empty sort keeps the
+// default order (status, priority, type, title).
+var ViewSorts = []string{"created", "updated", "due", "status", "priority", "id"}
+
+// ViewColumns lists the built-in columns a view can show. Declared custom
+// fields can be used as columns too.
+var ViewColumns = []string{
+	"id", "title", "status", "type", "priority", "tags", "assignees", "parent",
+	"due", "estimate", "created", "updated",
+}
+
+// viewNamePattern matches valid view names: lowercase letters, numbers,
+// dashes and underscores, starting with a letter.
+var viewNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// ViewConfig declares a named view: a saved filter with a sort order and the
+// columns to show.
+type ViewConfig struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	// Filter uses the field names of the GraphQL BeanFilter input, e.g.
+	// {status: [todo], tags: [bug], isBlocked: false}.
+	Filter  map[string]any `yaml:"filter,omitempty"`
+	Sort    string         `yaml:"sort,omitempty"`
+	Columns []string       `yaml:"columns,omitempty"`
+}
+
+// GetView returns the ViewConfig for a given view name, or nil if not declared.
+func (c *Config) GetView(name string) *ViewConfig {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Views {
+		if c.Views[i].Name == name {
+			return &c.Views[i]
+		}
+	}
+	return nil
+}
+
+// ViewNames returns the names of all declared views.
+func (c *Config) ViewNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, len(c.Views))
+	for i, v := range c.Views {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// IsValidViewColumn returns true if name is a built-in column or a declared
+// custom field.
+func (c *Config) IsValidViewColumn(name string) bool {
+	return slices.Contains(ViewColumns, name) || c.GetField(name) != nil
+}
+
+// ValidateViews checks the declared views for missing, invalid or duplicate
+// names, unknown sort orders and unknown columns. Filters are checked where
+// they are converted to a BeanFilter. Returns one message per problem found.
+func (c *Config) ValidateViews() []string {
+	var problems []string
+	seen := make(map[string]bool)
+	for _, v := range c.Views {
+		switch {
+		case v.Name == "":
+			problems = append(problems, "view is missing a name")
+			continue
+		case !viewNamePattern.MatchString(v.Name):
+			problems = append(problems, fmt.Sprintf("view '%s' has an invalid name (use lowercase letters, numbers, dashes and underscores)", v.Name))
+		case seen[v.Name]:
+			problems = append(problems, fmt.Sprintf("view '%s' is declared more than once", v.Name))
+		}
+		seen[v.Name] = true
+
+		if v.Sort != "" && !slices.Contains(ViewSorts, v.Sort) {
+			problems = append(problems, fmt.Sprintf("view '%s' has unknown sort '%s' (must be %s)", v.Name, v.Sort, strings.Join(ViewSorts, ", ")))
+		}
+		for _, col := range v.Columns {
+			if !c.IsValidViewColumn(col) {
+				problems = append(problems, fmt.Sprintf("view '%s' has unknown column '%s' (must be %s, or a custom field)", v.Name, col, strings.Join(ViewColumns, ", ")))
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateViews(t *testing.T) {
+	cfg := Default()
+	cfg.Fields = []FieldConfig{{Name: "team", Type: FieldTypeString}}
+	cfg.Views = []ViewConfig{
+		{Name: "triage", Sort: "created", Columns: []string{"id", "title", "team"}},
+		{Sort: "id"},
+		{Name: "My View"},
+		{Name: "triage"},
+		{Name: "late", Sort: "oldest"},
+		{Name: "wide", Columns: []string{"id", "owner"}},
+	}
+	problems := cfg.ValidateViews()
+
+	want := []string{
+		"view is missing a name",
+		"view 'My View' has an invalid name",
+		"view 'triage' is declared more than once",
+		"view 'late' has unknown sort 'oldest'",
+		"view 'wide' has unknown column 'owner'",
+	}
+	if len(problems) != len(want) {
+		t.Fatalf("ValidateViews() = %v, want %d problems", problems, len(want))
+	}
+	for i, w := range want {
+		if !strings.HasPrefix(problems[i], w) {
+			t.Errorf("problem %d = %q, want prefix %q", i, problems[i], w)
+		}
+	}
+}
+
+func TestLoadAndSaveViews(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := Default()
+	cfg.Views = []ViewConfig{{
+		Name:    "triage",
+		Filter:  map[string]any{"status": []any{"draft"}, "noParent": true},
+		Sort:    "created",
+		Columns: []string{"id", "title"},
+	}}
+	cfg.SetConfigDir(tmpDir)
+	if err := cfg.Save(tmpDir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filepath.Join(tmpDir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	v := loaded.GetView("triage")
+	if v == nil || v.Sort != "created" || len(v.Columns) != 2 {
+		t.Fatalf("GetView(triage) = %+v after reload", v)
+	}
+	if v.Filter["noParent"] != true {
+		t.Errorf("Filter = %v after reload, want noParent: true", v.Filter)
+	}
+	if loaded.GetView("missing") != nil {
+		t.Error("GetView(missing) != nil")
+	}
+}
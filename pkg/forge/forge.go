@@ -1,9 +1,11 @@
-// Package forge provides an abstraction over git hosting providers (GitHub, GitLab, etc.)
-// for pull/merge request operations. It uses the provider's CLI tool (gh, glab) under the hood.
+// Package forge provides an abstraction over git hosting providers (GitHub, GitLab, Gitea, etc.)
+// for pull/merge request and issue operations. It uses the provider's CLI tool (gh, glab) under the hood,
+// or the REST API for Gitea and Forgejo.
 package forge
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -26,8 +28,33 @@ type Provider interface {
 
 	// CreatePR creates a new pull/merge request and returns it.
 	CreatePR(ctx context.Context, repoDir string, opts CreatePROpts) (*PullRequest, error)
+
+	// MergePR merges the pull/merge request with the given number.
+	MergePR(ctx context.Context, repoDir string, number int, method MergeMethod) error
+
+	// ClosePR closes the pull/merge request with the given number without merging it.
+	ClosePR(ctx context.Context, repoDir string, number int) error
+
+	// RequestReview asks the given users (forge usernames) to review a pull/merge request.
+	RequestReview(ctx context.Context, repoDir string, number int, reviewers []string) error
+
+	// RerunFailedChecks re-runs the failed CI checks of a pull/merge request.
+	RerunFailedChecks(ctx context.Context, repoDir string, number int) error
+
+	// GetIssue returns the issue with the given number, including its comments.
+	GetIssue(ctx context.Context, repoDir string, number int) (*Issue, error)
+
+	// CreateIssue creates a new issue and returns it.
+	CreateIssue(ctx context.Context, repoDir string, opts CreateIssueOpts) (*Issue, error)
+
+	// UpdateIssue changes an issue's title, body or state and returns it.
+	UpdateIssue(ctx context.Context, repoDir string, number int, opts UpdateIssueOpts) (*Issue, error)
 }
 
+// ErrNotSupported is returned for operations a forge provider doesn't
+// implement.
+var ErrNotSupported = errors.New("not supported by this forge")
+
 // CheckStatus represents the aggregate state of CI checks on a PR.
 type CheckStatus string
 
@@ -64,20 +91,32 @@ type CreatePROpts struct {
 }
 
 // Detect auto-detects the forge provider from the git remote URL in the given repo directory.
+// giteaHosts lists the self-hosted Gitea and Forgejo instances to recognize (see GiteaHosts
+// in the project config); these use the REST API and need no CLI tool.
 // Returns nil if no supported forge is detected or the corresponding CLI tool is not installed.
-func Detect(repoDir string) Provider {
+func Detect(repoDir string, giteaHosts []string) Provider {
 	remoteURL := getOriginURL(repoDir)
 	if remoteURL == "" {
 		return nil
 	}
 
+	// Configured Gitea hosts are matched first, since they are explicit.
+	// GitLab is matched by host before GitHub, since isGitHub also matches
+	// "github" anywhere in the URL, such as in a GitLab project's name.
+	if baseURL := giteaBaseURL(remoteURL, giteaHosts); baseURL != "" {
+		return &Gitea{BaseURL: baseURL, Token: giteaToken(baseURL)}
+	}
 	switch {
+	case isGitLab(remoteURL):
+		if !hasCLI("glab") {
+			return nil
+		}
+		return &GitLab{}
 	case isGitHub(remoteURL):
 		if !hasCLI("gh") {
 			return nil
 		}
 		return &GitHub{}
-	// Future: case isGitLab(remoteURL): return &GitLab{}
 	default:
 		return nil
 	}
@@ -0,0 +1,446 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Gitea implements the Provider interface for Gitea and Forgejo instances,
+// which share the same REST API (/api/v1). Unlike GitHub and GitLab it talks
+// to the API directly, so the tea CLI is only needed by agents, not by beans.
+type Gitea struct {
+	// BaseURL is the root URL of the instance, e.g. "https://git.example.org".
+	BaseURL string
+	// Token authenticates API requests. Public repositories work without one.
+	Token string
+	// Client makes the API requests (giteaClient if nil).
+	Client *http.Client
+}
+
+// giteaClient is the HTTP client used when Gitea.Client is nil.
+var giteaClient = &http.Client{Timeout: 30 * time.Second}
+
+// giteaPageSize and giteaMaxPages bound how many recently updated PRs
+// FindPRs scans for the requested branches.
+const (
+	giteaPageSize = 50
+	giteaMaxPages = 5
+)
+
+func (g *Gitea) Name() string    { return "gitea" }
+func (g *Gitea) CLIName() string { return "tea" }
+
+// giteaPR is the JSON shape of a pull request in the Gitea API.
+type giteaPR struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"` // "open", "closed"
+	Merged    bool   `json:"merged"`
+	HTMLURL   string `json:"html_url"`
+	Draft     bool   `json:"draft"` // only reported by newer versions; older ones use a WIP title prefix
+	Mergeable bool   `json:"mergeable"`
+	Head      struct {
+		Ref    string `json:"ref"`
+		SHA    string `json:"sha"`
+		RepoID int64  `json:"repo_id"`
+	} `json:"head"`
+	Base struct {
+		RepoID int64 `json:"repo_id"`
+	} `json:"base"`
+}
+
+// giteaCombinedStatus is the JSON shape of a commit's combined status,
+// holding the latest status of each CI context.
+type giteaCombinedStatus struct {
+	Statuses []struct {
+		Status string `json:"status"` // "pending", "success", "error", "failure", "warning"
+	} `json:"statuses"`
+}
+
+// giteaReview is the JSON shape of a pull request review.
+type giteaReview struct {
+	State     string `json:"state"` // "APPROVED", "REQUEST_CHANGES", "COMMENT", "PENDING", "REQUEST_REVIEW"
+	Dismissed bool   `json:"dismissed"`
+	User      struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+// giteaWIPPrefixes are the title prefixes Gitea uses to mark a PR as work in
+// progress by default.
+var giteaWIPPrefixes = []string{"WIP:", "[WIP]"}
+
+func (g *Gitea) FindPR(ctx context.Context, repoDir string, branch string) (*PullRequest, error) {
+	prs, err := g.FindPRs(ctx, repoDir, []string{branch})
+	if err != nil {
+		return nil, nil
+	}
+	return prs[branch], nil
+}
+
+// FindPRs scans the repository's most recently updated PRs for the given
+// branches, since the API can't filter PRs by head branch. An open PR is
+// preferred over a merged one; closed, unmerged PRs and PRs from forks are
+// ignored.
+func (g *Gitea) FindPRs(ctx context.Context, repoDir string, branches []string) (map[string]*PullRequest, error) {
+	if len(branches) == 0 {
+		return map[string]*PullRequest{}, nil
+	}
+
+	repoPath, err := giteaRepoPath(repoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(branches))
+	for _, branch := range branches {
+		wanted[branch] = true
+	}
+	open := make(map[string]giteaPR)
+	merged := make(map[string]giteaPR)
+	for page := 1; page <= giteaMaxPages && len(open) < len(wanted); page++ {
+		var prs []giteaPR
+		path := fmt.Sprintf("/repos/%s/pulls?state=all&sort=recentupdate&limit=%d&page=%d", repoPath, giteaPageSize, page)
+		if err := g.request(ctx, http.MethodGet, path, nil, &prs); err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			branch := pr.Head.Ref
+			if !wanted[branch] || pr.Head.RepoID != pr.Base.RepoID {
+				continue
+			}
+			if _, ok := open[branch]; !ok && pr.State == "open" {
+				open[branch] = pr
+			} else if _, ok := merged[branch]; !ok && pr.Merged {
+				merged[branch] = pr
+			}
+		}
+		if len(prs) < giteaPageSize {
+			break
+		}
+	}
+
+	result := make(map[string]*PullRequest, len(branches))
+	for _, branch := range branches {
+		pr, ok := open[branch]
+		if !ok {
+			if pr, ok = merged[branch]; !ok {
+				continue
+			}
+		}
+		if result[branch], err = g.toForge(ctx, repoPath, pr); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// CreatePR opens a PR from the current branch. Gitea has no draft flag when
+// creating a PR, so drafts get a WIP title prefix.
+func (g *Gitea) CreatePR(ctx context.Context, repoDir string, opts CreatePROpts) (*PullRequest, error) {
+	repoPath, err := giteaRepoPath(repoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "--short", "HEAD")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("determining current branch: %w", err)
+	}
+	head := strings.TrimSpace(string(out))
+
+	base := opts.BaseBranch
+	if base == "" {
+		var repo struct {
+			DefaultBranch string `json:"default_branch"`
+		}
+		if err := g.request(ctx, http.MethodGet, "/repos/"+repoPath, nil, &repo); err != nil {
+			return nil, err
+		}
+		base = repo.DefaultBranch
+	}
+
+	title := opts.Title
+	if opts.Draft && !isGiteaWIP(title) {
+		title = giteaWIPPrefixes[0] + " " + title
+	}
+
+	var pr giteaPR
+	body := map[string]string{"head": head, "base": base, "title": title, "body": opts.Body}
+	if err := g.request(ctx, http.MethodPost, "/repos/"+repoPath+"/pulls", body, &pr); err != nil {
+		return nil, err
+	}
+	return g.toForge(ctx, repoPath, pr)
+}
+
+// toForge converts a Gitea PR to our PullRequest type, fetching the CI
+// status of its head commit and its reviews.
+func (g *Gitea) toForge(ctx context.Context, repoPath string, pr giteaPR) (*PullRequest, error) {
+	var status giteaCombinedStatus
+	if err := g.request(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/commits/%s/status", repoPath, pr.Head.SHA), nil, &status); err != nil {
+		return nil, err
+	}
+	var reviews []giteaReview
+	if err := g.request(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/pulls/%d/reviews", repoPath, pr.Number), nil, &reviews); err != nil {
+		return nil, err
+	}
+
+	state := normalizeState(pr.State)
+	if pr.Merged {
+		state = "merged"
+	}
+	checks := make([]ghStatusCheck, 0, len(status.Statuses))
+	for _, s := range status.Statuses {
+		checks = append(checks, giteaStatusCheck(s.Status))
+	}
+	return &PullRequest{
+		Number:         pr.Number,
+		Title:          pr.Title,
+		State:          state,
+		URL:            pr.HTMLURL,
+		IsDraft:        pr.Draft || isGiteaWIP(pr.Title),
+		Checks:         computeCheckStatus(checks),
+		ReviewApproved: giteaReviewApproved(reviews),
+		Mergeable:      pr.Mergeable,
+	}, nil
+}
+
+// giteaStatusCheck converts a Gitea commit status to our internal format.
+// Warnings don't fail a PR.
+func giteaStatusCheck(status string) ghStatusCheck {
+	switch status {
+	case "success", "warning":
+		return ghStatusCheck{Status: "COMPLETED", Conclusion: "SUCCESS"}
+	case "pending":
+		return ghStatusCheck{Status: "IN_PROGRESS", Conclusion: ""}
+	default: // error, failure
+		return ghStatusCheck{Status: "COMPLETED", Conclusion: "FAILURE"}
+	}
+}
+
+// giteaReviewApproved reports whether no reviewer's latest review requests
+// changes. Gitea doesn't report whether approvals are required, so like a
+// GitHub repo without required reviews, a PR without reviews is approved.
+func giteaReviewApproved(reviews []giteaReview) bool {
+	latest := make(map[string]string)
+	for _, r := range reviews {
+		if r.Dismissed || (r.State != "APPROVED" && r.State != "REQUEST_CHANGES") {
+			continue
+		}
+		latest[r.User.Login] = r.State // reviews are listed oldest first
+	}
+	for _, state := range latest {
+		if state == "REQUEST_CHANGES" {
+			return false
+		}
+	}
+	return true
+}
+
+// isGiteaWIP reports whether a PR title marks the PR as work in progress.
+func isGiteaWIP(title string) bool {
+	for _, prefix := range giteaWIPPrefixes {
+		if len(title) >= len(prefix) && strings.EqualFold(title[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Gitea) MergePR(ctx context.Context, repoDir string, number int, method MergeMethod) error {
+	repoPath, err := giteaRepoPath(repoDir)
+	if err != nil {
+		return err
+	}
+	style := string(method)
+	if method == MergeMethodDefault {
+		var repo struct {
+			DefaultMergeStyle string `json:"default_merge_style"`
+		}
+		if err := g.request(ctx, http.MethodGet, "/repos/"+repoPath, nil, &repo); err != nil {
+			return err
+		}
+		if style = repo.DefaultMergeStyle; style == "" {
+			style = string(MergeMethodMerge)
+		}
+	}
+	return g.request(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/pulls/%d/merge", repoPath, number), map[string]string{"Do": style}, nil)
+}
+
+func (g *Gitea) ClosePR(ctx context.Context, repoDir string, number int) error {
+	repoPath, err := giteaRepoPath(repoDir)
+	if err != nil {
+		return err
+	}
+	return g.request(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/pulls/%d", repoPath, number), map[string]string{"state": "closed"}, nil)
+}
+
+func (g *Gitea) RequestReview(ctx context.Context, repoDir string, number int, reviewers []string) error {
+	repoPath, err := giteaRepoPath(repoDir)
+	if err != nil {
+		return err
+	}
+	return g.request(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", repoPath, number), map[string][]string{"reviewers": reviewers}, nil)
+}
+
+// RerunFailedChecks is not supported: commit statuses come from external CI
+// services, and Gitea's API has no endpoint to re-run Actions jobs.
+func (g *Gitea) RerunFailedChecks(ctx context.Context, repoDir string, number int) error {
+	return fmt.Errorf("gitea checks: %w", ErrNotSupported)
+}
+
+func (g *Gitea) GetIssue(ctx context.Context, repoDir string, number int) (*Issue, error) {
+	return nil, fmt.Errorf("gitea issues: %w", ErrNotSupported)
+}
+
+func (g *Gitea) CreateIssue(ctx context.Context, repoDir string, opts CreateIssueOpts) (*Issue, error) {
+	return nil, fmt.Errorf("gitea issues: %w", ErrNotSupported)
+}
+
+func (g *Gitea) UpdateIssue(ctx context.Context, repoDir string, number int, opts UpdateIssueOpts) (*Issue, error) {
+	return nil, fmt.Errorf("gitea issues: %w", ErrNotSupported)
+}
+
+// request sends a JSON request to the Gitea API and decodes the response
+// into v, unless v is nil. path is relative to /api/v1.
+func (g *Gitea) request(ctx context.Context, method, path string, body, v any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(g.BaseURL, "/")+"/api/v1"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if g.Token != "" {
+		req.Header.Set("Authorization", "token "+g.Token)
+	}
+
+	client := g.Client
+	if client == nil {
+		client = giteaClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("gitea api: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("gitea api: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("gitea api: %s %s: %s", method, path, apiErr.Message)
+		}
+		return fmt.Errorf("gitea api: %s %s: %s", method, path, resp.Status)
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parsing gitea response: %w", err)
+	}
+	return nil
+}
+
+// giteaRepoPath returns the "owner/repo" path of the origin remote, escaped
+// for use in API paths.
+func giteaRepoPath(repoDir string) (string, error) {
+	_, path, ok := splitRemoteURL(getOriginURL(repoDir))
+	owner, repo, found := strings.Cut(path, "/")
+	if !ok || !found || strings.Contains(repo, "/") {
+		return "", fmt.Errorf("cannot parse Gitea owner/repo from remote URL")
+	}
+	return url.PathEscape(owner) + "/" + url.PathEscape(repo), nil
+}
+
+// giteaBaseURL returns the base URL of the configured Gitea host the remote
+// URL points to, or "" if it points to none of them. Hosts are given as a
+// bare host (served over HTTPS) or a base URL; ports are ignored when
+// matching, since SSH and HTTP usually use different ones.
+func giteaBaseURL(remoteURL string, hosts []string) string {
+	remoteHost, _, ok := splitRemoteURL(remoteURL)
+	if !ok {
+		return ""
+	}
+	for _, h := range hosts {
+		base := strings.TrimSuffix(strings.TrimSpace(h), "/")
+		if !strings.Contains(base, "://") {
+			base = "https://" + base
+		}
+		u, err := url.Parse(base)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		if strings.EqualFold(u.Hostname(), remoteHost) {
+			return base
+		}
+	}
+	return ""
+}
+
+// giteaToken returns the API token for a Gitea instance: GITEA_TOKEN or
+// FORGEJO_TOKEN, or the token of a tea login for the same host.
+func giteaToken(baseURL string) string {
+	for _, env := range []string{"GITEA_TOKEN", "FORGEJO_TOKEN"} {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(configDir, "tea", "config.yml"))
+	if err != nil {
+		return ""
+	}
+	var config struct {
+		Logins []struct {
+			URL   string `yaml:"url"`
+			Token string `yaml:"token"`
+		} `yaml:"logins"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return ""
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+	for _, login := range config.Logins {
+		if u, err := url.Parse(login.URL); err == nil && strings.EqualFold(u.Hostname(), base.Hostname()) {
+			return login.Token
+		}
+	}
+	return ""
+}
@@ -0,0 +1,322 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeGitea is a stand-in for the Gitea API of repository acme/app (repo ID 1).
+type fakeGitea struct {
+	t        *testing.T
+	prs      []map[string]any
+	statuses map[string][]string // commit SHA -> CI statuses
+	reviews  map[string][]any    // PR number -> reviews, oldest first
+	created  map[string]string   // body of the last PR creation request
+	actions  []string            // PR changes requested, as "METHOD path body"
+}
+
+// giteaTestPR returns the JSON of a PR from the given head repo.
+func giteaTestPR(number int, title, branch, state string, merged, mergeable bool, headRepoID int) map[string]any {
+	return map[string]any{
+		"number":    number,
+		"title":     title,
+		"state":     state,
+		"merged":    merged,
+		"mergeable": mergeable,
+		"html_url":  "https://git.example.org/acme/app/pulls/" + strconv.Itoa(number),
+		"head":      map[string]any{"ref": branch, "sha": "sha" + strconv.Itoa(number), "repo_id": headRepoID},
+		"base":      map[string]any{"ref": "main", "repo_id": 1},
+	}
+}
+
+func (f *fakeGitea) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if got := r.Header.Get("Authorization"); got != "token secret" {
+		f.t.Errorf("%s %s: Authorization = %q, want the token", r.Method, r.URL.Path, got)
+	}
+	reply := func(v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/repos/acme/app")
+	switch {
+	case path == r.URL.Path:
+		w.WriteHeader(http.StatusNotFound)
+		reply(map[string]string{"message": "The target couldn't be found."})
+	case path == "" && r.Method == http.MethodGet:
+		reply(map[string]any{"full_name": "acme/app", "default_branch": "main", "default_merge_style": "squash"})
+	case path == "/pulls" && r.Method == http.MethodGet:
+		q := r.URL.Query()
+		if q.Get("state") != "all" || q.Get("sort") != "recentupdate" {
+			f.t.Errorf("listing PRs with %s, want all PRs by recent update", r.URL.RawQuery)
+		}
+		if q.Get("page") != "1" {
+			reply([]any{})
+			return
+		}
+		reply(f.prs)
+	case path == "/pulls" && r.Method == http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&f.created); err != nil {
+			f.t.Fatal(err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		reply(giteaTestPR(22, f.created["title"], f.created["head"], "open", false, true, 1))
+	case strings.HasPrefix(path, "/pulls/") && r.Method != http.MethodGet:
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			f.t.Fatal(err)
+		}
+		data, _ := json.Marshal(body)
+		f.actions = append(f.actions, r.Method+" "+path+" "+string(data))
+		if strings.HasSuffix(path, "/merge") {
+			return // Gitea answers a merge with an empty body
+		}
+		reply(map[string]any{})
+	case strings.HasPrefix(path, "/commits/") && strings.HasSuffix(path, "/status"):
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, "/commits/"), "/status")
+		statuses := []any{}
+		for _, s := range f.statuses[sha] {
+			statuses = append(statuses, map[string]any{"status": s, "context": "ci/" + s})
+		}
+		reply(map[string]any{"sha": sha, "statuses": statuses})
+	case strings.HasPrefix(path, "/pulls/") && strings.HasSuffix(path, "/reviews"):
+		reviews := f.reviews[strings.TrimSuffix(strings.TrimPrefix(path, "/pulls/"), "/reviews")]
+		if reviews == nil {
+			reviews = []any{}
+		}
+		reply(reviews)
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func giteaTestReview(login, state string) map[string]any {
+	return map[string]any{"state": state, "user": map[string]any{"login": login}}
+}
+
+func newFakeGitea(t *testing.T) (*fakeGitea, *httptest.Server) {
+	f := &fakeGitea{
+		t: t,
+		prs: []map[string]any{
+			giteaTestPR(21, "WIP: Search", "search", "open", false, false, 1),
+			giteaTestPR(20, "Dark mode", "dark-mode", "open", false, true, 1),
+			giteaTestPR(19, "Fix login (fork)", "fix-login", "open", false, true, 2),
+			giteaTestPR(12, "Fix login", "fix-login", "closed", true, false, 1),
+			giteaTestPR(11, "Old attempt", "old", "closed", false, false, 1),
+		},
+		statuses: map[string][]string{
+			"sha20": {"success", "warning"},
+			"sha21": {"pending", "success"},
+			"sha12": {"failure", "success"},
+		},
+		reviews: map[string][]any{
+			"20": {giteaTestReview("alice", "REQUEST_CHANGES"), giteaTestReview("bob", "COMMENT"), giteaTestReview("alice", "APPROVED")},
+			"21": {giteaTestReview("bob", "REQUEST_CHANGES")},
+		},
+	}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return f, server
+}
+
+func TestGiteaFindPRs(t *testing.T) {
+	_, server := newFakeGitea(t)
+	repoDir := gitRepoWithOrigin(t, server.URL+"/acme/app.git")
+	g := &Gitea{BaseURL: server.URL, Token: "secret"}
+
+	prs, err := g.FindPRs(context.Background(), repoDir, []string{"dark-mode", "fix-login", "search", "old", "none"})
+	if err != nil {
+		t.Fatalf("FindPRs() error = %v", err)
+	}
+
+	if len(prs) != 3 {
+		t.Fatalf("FindPRs() returned %d PRs, want 3: %v", len(prs), prs)
+	}
+	if pr := prs["dark-mode"]; pr.Number != 20 || pr.State != "open" || pr.Checks != CheckStatusPass || !pr.ReviewApproved || !pr.CanMerge() {
+		t.Errorf("dark-mode = %+v, want open, passing, approved and mergeable PR 20", pr)
+	}
+	if pr := prs["fix-login"]; pr.Number != 12 || pr.State != "merged" || pr.Checks != CheckStatusFail {
+		t.Errorf("fix-login = %+v, want merged PR 12 with failed checks, not the fork's PR", pr)
+	}
+	if pr := prs["search"]; pr.Number != 21 || !pr.IsDraft || pr.Checks != CheckStatusPending || pr.ReviewApproved {
+		t.Errorf("search = %+v, want WIP PR 21 with pending checks and requested changes", pr)
+	}
+	for _, branch := range []string{"old", "none"} {
+		if pr, ok := prs[branch]; ok {
+			t.Errorf("%s = %+v, want no PR", branch, pr)
+		}
+	}
+
+	pr, err := g.FindPR(context.Background(), repoDir, "dark-mode")
+	if err != nil || pr == nil || pr.Number != 20 {
+		t.Errorf("FindPR() = %+v, %v, want PR 20", pr, err)
+	}
+}
+
+func TestGiteaFindPRsRepoNotFound(t *testing.T) {
+	_, server := newFakeGitea(t)
+	repoDir := gitRepoWithOrigin(t, server.URL+"/acme/gone.git")
+	g := &Gitea{BaseURL: server.URL, Token: "secret"}
+
+	_, err := g.FindPRs(context.Background(), repoDir, []string{"main"})
+	if err == nil || !strings.Contains(err.Error(), "The target couldn't be found.") {
+		t.Errorf("FindPRs() error = %v, want the API's error message", err)
+	}
+	if pr, err := g.FindPR(context.Background(), repoDir, "main"); pr != nil || err != nil {
+		t.Errorf("FindPR() = %+v, %v, want nil, nil", pr, err)
+	}
+}
+
+func TestGiteaCreatePR(t *testing.T) {
+	f, server := newFakeGitea(t)
+	// The SSH remote is on the same host as the API, on another port
+	repoDir := gitRepoWithOrigin(t, "ssh://git@127.0.0.1:2222/acme/app.git")
+	cmd := exec.Command("git", "symbolic-ref", "HEAD", "refs/heads/export")
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git symbolic-ref: %v: %s", err, out)
+	}
+	g := &Gitea{BaseURL: server.URL, Token: "secret"}
+
+	pr, err := g.CreatePR(context.Background(), repoDir, CreatePROpts{Title: "Add export", Body: "Exports beans", Draft: true})
+	if err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	want := map[string]string{"head": "export", "base": "main", "title": "WIP: Add export", "body": "Exports beans"}
+	for k, v := range want {
+		if f.created[k] != v {
+			t.Errorf("created PR %s = %q, want %q", k, f.created[k], v)
+		}
+	}
+	if pr.Number != 22 || !pr.IsDraft || pr.State != "open" || pr.Checks != CheckStatusPass {
+		t.Errorf("CreatePR() = %+v, want open draft PR 22 without checks", pr)
+	}
+}
+
+func TestGiteaStatusChecks(t *testing.T) {
+	tests := []struct {
+		statuses []string
+		expected CheckStatus
+	}{
+		{nil, CheckStatusPass},
+		{[]string{"success", "warning"}, CheckStatusPass},
+		{[]string{"success", "error"}, CheckStatusFail},
+		{[]string{"failure"}, CheckStatusFail},
+		{[]string{"failure", "pending"}, CheckStatusPending},
+	}
+
+	for _, tc := range tests {
+		var checks []ghStatusCheck
+		for _, s := range tc.statuses {
+			checks = append(checks, giteaStatusCheck(s))
+		}
+		if got := computeCheckStatus(checks); got != tc.expected {
+			t.Errorf("statuses %v = %q, want %q", tc.statuses, got, tc.expected)
+		}
+	}
+}
+
+func TestGiteaBaseURL(t *testing.T) {
+	hosts := []string{"git.example.org", "http://forgejo.lan:3000/", "  "}
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"git@git.example.org:acme/app.git", "https://git.example.org"},
+		{"https://GIT.example.org/acme/app", "https://git.example.org"},
+		{"ssh://git@forgejo.lan:2222/acme/app.git", "http://forgejo.lan:3000"},
+		{"http://forgejo.lan:3000/acme/app.git", "http://forgejo.lan:3000"},
+		{"git@github.com:acme/app.git", ""},
+		{"", ""},
+	}
+
+	for _, tc := range tests {
+		if got := giteaBaseURL(tc.url, hosts); got != tc.expected {
+			t.Errorf("giteaBaseURL(%q) = %q, want %q", tc.url, got, tc.expected)
+		}
+	}
+}
+
+func TestGiteaToken(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+	t.Setenv("GITEA_TOKEN", "")
+	t.Setenv("FORGEJO_TOKEN", "")
+	teaConfig := "logins:\n  - name: codeberg\n    url: https://codeberg.org\n    token: cb\n  - name: work\n    url: https://git.example.org\n    token: work-token\n"
+	if err := os.MkdirAll(filepath.Join(configDir, "tea"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "tea", "config.yml"), []byte(teaConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := giteaToken("https://git.example.org"); got != "work-token" {
+		t.Errorf("token from tea login = %q, want %q", got, "work-token")
+	}
+	if got := giteaToken("https://forgejo.lan"); got != "" {
+		t.Errorf("token without a tea login = %q, want none", got)
+	}
+	t.Setenv("FORGEJO_TOKEN", "env-token")
+	if got := giteaToken("https://git.example.org"); got != "env-token" {
+		t.Errorf("token from FORGEJO_TOKEN = %q, want %q", got, "env-token")
+	}
+}
+
+func TestDetectGitea(t *testing.T) {
+	t.Setenv("GITEA_TOKEN", "secret")
+	repoDir := gitRepoWithOrigin(t, "git@git.example.org:acme/app.git")
+
+	if p := Detect(repoDir, nil); p != nil {
+		t.Errorf("Detect() without configured hosts = %v, want nil", p)
+	}
+	p, ok := Detect(repoDir, []string{"git.example.org"}).(*Gitea)
+	if !ok || p.BaseURL != "https://git.example.org" || p.Token != "secret" {
+		t.Errorf("Detect() = %+v, want Gitea at https://git.example.org with the token", p)
+	}
+}
+
+func TestGiteaPRActions(t *testing.T) {
+	f, server := newFakeGitea(t)
+	repoDir := gitRepoWithOrigin(t, server.URL+"/acme/app.git")
+	g := &Gitea{BaseURL: server.URL, Token: "secret"}
+
+	if err := g.MergePR(context.Background(), repoDir, 20, MergeMethodRebase); err != nil {
+		t.Fatalf("MergePR() error = %v", err)
+	}
+	// Without a method, the repository's default merge style is used
+	if err := g.MergePR(context.Background(), repoDir, 20, MergeMethodDefault); err != nil {
+		t.Fatalf("MergePR() with the default method error = %v", err)
+	}
+	if err := g.ClosePR(context.Background(), repoDir, 21); err != nil {
+		t.Fatalf("ClosePR() error = %v", err)
+	}
+	if err := g.RequestReview(context.Background(), repoDir, 21, []string{"alice", "bob"}); err != nil {
+		t.Fatalf("RequestReview() error = %v", err)
+	}
+
+	want := []string{
+		`POST /pulls/20/merge {"Do":"rebase"}`,
+		`POST /pulls/20/merge {"Do":"squash"}`,
+		`PATCH /pulls/21 {"state":"closed"}`,
+		`POST /pulls/21/requested_reviewers {"reviewers":["alice","bob"]}`,
+	}
+	if !slices.Equal(f.actions, want) {
+		t.Errorf("requests = %q, want %q", f.actions, want)
+	}
+
+	if err := g.RerunFailedChecks(context.Background(), repoDir, 21); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("RerunFailedChecks() error = %v, want ErrNotSupported", err)
+	}
+}
@@ -329,7 +329,7 @@ func (g *GitHub) findPRByURL(ctx context.Context, repoDir string, url string) (*
 // normalizeState converts forge-specific states to our canonical form.
 func normalizeState(state string) string {
 	switch strings.ToUpper(state) {
-	case "OPEN":
+	case "OPEN", "OPENED":
 		return "open"
 	case "CLOSED":
 		return "closed"
@@ -0,0 +1,402 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GitLab implements the Provider interface using the glab CLI. It talks to
+// GitLab's GraphQL API through `glab api`, which picks the instance (gitlab.com
+// or self-hosted) from the repository's remote.
+type GitLab struct{}
+
+func (g *GitLab) Name() string    { return "gitlab" }
+func (g *GitLab) CLIName() string { return "glab" }
+
+// glMergeRequest represents a merge request node from the GitLab GraphQL API.
+type glMergeRequest struct {
+	IID                 string `json:"iid"` // the MR number within the project, as a string
+	Title               string `json:"title"`
+	State               string `json:"state"` // "opened", "closed", "merged", "locked"
+	WebURL              string `json:"webUrl"`
+	Draft               bool   `json:"draft"`
+	DetailedMergeStatus string `json:"detailedMergeStatus"` // "MERGEABLE", "NOT_APPROVED", "CI_STILL_RUNNING", ...
+	Approved            bool   `json:"approved"`            // all required approvals are given
+	HeadPipeline        *struct {
+		Status string `json:"status"` // "SUCCESS", "FAILED", "RUNNING", ...
+	} `json:"headPipeline"`
+}
+
+const mrGraphQLFields = `iid title state webUrl draft detailedMergeStatus approved headPipeline { status }`
+
+// glMergeRequestToForge converts a GitLab GraphQL merge request to our PullRequest type.
+func glMergeRequestToForge(mr glMergeRequest) *PullRequest {
+	number, _ := strconv.Atoi(mr.IID)
+	checks := CheckStatusPass
+	if mr.HeadPipeline != nil {
+		checks = pipelineCheckStatus(mr.HeadPipeline.Status)
+	}
+	return &PullRequest{
+		Number:         number,
+		Title:          mr.Title,
+		State:          normalizeState(mr.State),
+		URL:            mr.WebURL,
+		IsDraft:        mr.Draft,
+		Checks:         checks,
+		ReviewApproved: mr.Approved,
+		Mergeable:      strings.EqualFold(mr.DetailedMergeStatus, "MERGEABLE"),
+	}
+}
+
+// pipelineCheckStatus maps a GitLab pipeline status to a CheckStatus.
+// Pipelines waiting for a manual job, or skipped entirely, don't block the MR.
+func pipelineCheckStatus(status string) CheckStatus {
+	switch strings.ToUpper(status) {
+	case "SUCCESS", "MANUAL", "SKIPPED":
+		return CheckStatusPass
+	case "FAILED", "CANCELED":
+		return CheckStatusFail
+	default: // CREATED, WAITING_FOR_RESOURCE, PREPARING, PENDING, RUNNING, SCHEDULED
+		return CheckStatusPending
+	}
+}
+
+func (g *GitLab) FindPR(ctx context.Context, repoDir string, branch string) (*PullRequest, error) {
+	prs, err := g.FindPRs(ctx, repoDir, []string{branch})
+	if err != nil {
+		return nil, nil
+	}
+	return prs[branch], nil
+}
+
+func (g *GitLab) FindPRs(ctx context.Context, repoDir string, branches []string) (map[string]*PullRequest, error) {
+	if len(branches) == 0 {
+		return map[string]*PullRequest{}, nil
+	}
+
+	_, path, ok := splitRemoteURL(getOriginURL(repoDir))
+	if !ok {
+		return nil, fmt.Errorf("cannot parse GitLab project path from remote URL")
+	}
+
+	// Build a single GraphQL query with two aliases per branch (open + merged).
+	var queryParts []string
+	for i, branch := range branches {
+		queryParts = append(queryParts,
+			fmt.Sprintf(`open%d: mergeRequests(sourceBranches: [%q], state: opened, first: 1) { nodes { %s } }`, i, branch, mrGraphQLFields),
+			fmt.Sprintf(`merged%d: mergeRequests(sourceBranches: [%q], state: merged, sort: CREATED_DESC, first: 1) { nodes { %s } }`, i, branch, mrGraphQLFields),
+		)
+	}
+	query := fmt.Sprintf(`{ project(fullPath: %q) { %s } }`, path, strings.Join(queryParts, "\n"))
+
+	var repoData map[string]struct {
+		Nodes []glMergeRequest `json:"nodes"`
+	}
+	if err := g.graphQL(ctx, repoDir, query, &repoData); err != nil {
+		return nil, err
+	}
+
+	// Resolve results: prefer open MR, fall back to merged.
+	result := make(map[string]*PullRequest, len(branches))
+	for i, branch := range branches {
+		if conn, ok := repoData[fmt.Sprintf("open%d", i)]; ok && len(conn.Nodes) > 0 {
+			result[branch] = glMergeRequestToForge(conn.Nodes[0])
+		} else if conn, ok := repoData[fmt.Sprintf("merged%d", i)]; ok && len(conn.Nodes) > 0 {
+			result[branch] = glMergeRequestToForge(conn.Nodes[0])
+		}
+	}
+	return result, nil
+}
+
+func (g *GitLab) CreatePR(ctx context.Context, repoDir string, opts CreatePROpts) (*PullRequest, error) {
+	args := []string{"mr", "create",
+		"--title", opts.Title,
+		"--description", opts.Body,
+		"--yes",
+	}
+	if opts.BaseBranch != "" {
+		args = append(args, "--target-branch", opts.BaseBranch)
+	}
+	if opts.Draft {
+		args = append(args, "--draft")
+	}
+
+	cmd := exec.CommandContext(ctx, "glab", args...)
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("glab mr create failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	// glab mr create prints the MR URL after a summary of the MR
+	var url string
+	for _, field := range strings.Fields(string(out)) {
+		if strings.Contains(field, "/merge_requests/") {
+			url = field
+		}
+	}
+	if url == "" {
+		return nil, fmt.Errorf("glab mr create printed no merge request URL: %s", strings.TrimSpace(string(out)))
+	}
+
+	// Fetch the created MR details
+	return g.findPRByURL(ctx, repoDir, url)
+}
+
+func (g *GitLab) findPRByURL(ctx context.Context, repoDir string, url string) (*PullRequest, error) {
+	fallback := &PullRequest{URL: url, State: "open"}
+	i := strings.LastIndex(url, "/merge_requests/")
+	_, path, ok := splitRemoteURL(getOriginURL(repoDir))
+	if i < 0 || !ok {
+		return fallback, nil
+	}
+	iid := url[i+len("/merge_requests/"):]
+	fallback.Number, _ = strconv.Atoi(iid)
+
+	query := fmt.Sprintf(`{ project(fullPath: %q) { mergeRequest(iid: %q) { %s } } }`, path, iid, mrGraphQLFields)
+	var repoData struct {
+		MergeRequest *glMergeRequest `json:"mergeRequest"`
+	}
+	if err := g.graphQL(ctx, repoDir, query, &repoData); err != nil || repoData.MergeRequest == nil {
+		return fallback, nil
+	}
+	return glMergeRequestToForge(*repoData.MergeRequest), nil
+}
+
+// graphQL runs a query against GitLab's GraphQL API and decodes its project
+// data into v.
+func (g *GitLab) graphQL(ctx context.Context, repoDir string, query string, v any) error {
+	var data struct {
+		Project json.RawMessage `json:"project"`
+	}
+	if err := g.graphQLData(ctx, repoDir, query, &data); err != nil {
+		return err
+	}
+	if len(data.Project) == 0 || string(data.Project) == "null" {
+		return fmt.Errorf("GitLab project not found")
+	}
+	if err := json.Unmarshal(data.Project, v); err != nil {
+		return fmt.Errorf("parsing project data: %w", err)
+	}
+	return nil
+}
+
+// graphQLData runs a query or mutation against GitLab's GraphQL API and
+// decodes its data into v.
+func (g *GitLab) graphQLData(ctx context.Context, repoDir string, query string, v any) error {
+	cmd := exec.CommandContext(ctx, "glab", "api", "graphql", "-f", "query="+query)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("glab api graphql: %w", err)
+	}
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(out, &envelope); err != nil {
+		return fmt.Errorf("parsing graphql response: %w", err)
+	}
+	if len(envelope.Errors) > 0 {
+		return fmt.Errorf("glab api graphql: %s", envelope.Errors[0].Message)
+	}
+	if len(envelope.Data) == 0 {
+		return fmt.Errorf("glab api graphql: empty response")
+	}
+	if err := json.Unmarshal(envelope.Data, v); err != nil {
+		return fmt.Errorf("parsing graphql data: %w", err)
+	}
+	return nil
+}
+
+func (g *GitLab) MergePR(ctx context.Context, repoDir string, number int, method MergeMethod) error {
+	args := []string{"mr", "merge", strconv.Itoa(number), "--yes"}
+	switch method {
+	case MergeMethodSquash:
+		args = append(args, "--squash")
+	case MergeMethodRebase:
+		args = append(args, "--rebase")
+	}
+	// MergeMethodMerge and MergeMethodDefault use the project's merge method
+	return runGlab(ctx, repoDir, args...)
+}
+
+func (g *GitLab) ClosePR(ctx context.Context, repoDir string, number int) error {
+	return runGlab(ctx, repoDir, "mr", "close", strconv.Itoa(number))
+}
+
+func (g *GitLab) RequestReview(ctx context.Context, repoDir string, number int, reviewers []string) error {
+	// A "+" prefix adds reviewers instead of replacing the current ones
+	add := make([]string, len(reviewers))
+	for i, r := range reviewers {
+		add[i] = "+" + r
+	}
+	return runGlab(ctx, repoDir, "mr", "update", strconv.Itoa(number), "--reviewer", strings.Join(add, ","))
+}
+
+// RerunFailedChecks retries the failed and canceled jobs of the MR's head
+// pipeline.
+func (g *GitLab) RerunFailedChecks(ctx context.Context, repoDir string, number int) error {
+	_, path, ok := splitRemoteURL(getOriginURL(repoDir))
+	if !ok {
+		return fmt.Errorf("cannot parse GitLab project path from remote URL")
+	}
+
+	query := fmt.Sprintf(`{ project(fullPath: %q) { mergeRequest(iid: "%d") { headPipeline { id status } } } }`, path, number)
+	var repoData struct {
+		MergeRequest *struct {
+			HeadPipeline *struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"headPipeline"`
+		} `json:"mergeRequest"`
+	}
+	if err := g.graphQL(ctx, repoDir, query, &repoData); err != nil {
+		return err
+	}
+	if repoData.MergeRequest == nil {
+		return fmt.Errorf("merge request !%d not found", number)
+	}
+	pipeline := repoData.MergeRequest.HeadPipeline
+	if pipeline == nil || pipelineCheckStatus(pipeline.Status) != CheckStatusFail {
+		return fmt.Errorf("no failed pipeline for merge request !%d", number)
+	}
+
+	mutation := fmt.Sprintf(`mutation { pipelineRetry(input: {id: %q}) { errors } }`, pipeline.ID)
+	var data struct {
+		PipelineRetry struct {
+			Errors []string `json:"errors"`
+		} `json:"pipelineRetry"`
+	}
+	if err := g.graphQLData(ctx, repoDir, mutation, &data); err != nil {
+		return err
+	}
+	if len(data.PipelineRetry.Errors) > 0 {
+		return fmt.Errorf("retrying pipeline: %s", strings.Join(data.PipelineRetry.Errors, "; "))
+	}
+	return nil
+}
+
+// runGlab runs a glab command, returning its output as the error if it fails.
+func runGlab(ctx context.Context, repoDir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "glab", args...)
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("glab %s %s failed: %s", args[0], args[1], strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (g *GitLab) GetIssue(ctx context.Context, repoDir string, number int) (*Issue, error) {
+	return nil, fmt.Errorf("gitlab issues: %w", ErrNotSupported)
+}
+
+func (g *GitLab) CreateIssue(ctx context.Context, repoDir string, opts CreateIssueOpts) (*Issue, error) {
+	return nil, fmt.Errorf("gitlab issues: %w", ErrNotSupported)
+}
+
+func (g *GitLab) UpdateIssue(ctx context.Context, repoDir string, number int, opts UpdateIssueOpts) (*Issue, error) {
+	return nil, fmt.Errorf("gitlab issues: %w", ErrNotSupported)
+}
+
+// isGitLab returns true if the remote URL points to a GitLab instance:
+// gitlab.com, a host with "gitlab" in its name, or a self-hosted instance
+// glab is configured for (GITLAB_HOST, or a host logged in with glab auth).
+func isGitLab(remoteURL string) bool {
+	host, _, ok := splitRemoteURL(remoteURL)
+	if !ok {
+		return false
+	}
+	host = strings.ToLower(host)
+	if strings.Contains(host, "gitlab") {
+		return true
+	}
+	for _, h := range glabHosts() {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// glabHosts returns the GitLab hosts glab is configured for: GITLAB_HOST or
+// GL_HOST, and the hosts in glab's config file.
+func glabHosts() []string {
+	var hosts []string
+	for _, env := range []string{"GITLAB_HOST", "GL_HOST"} {
+		if v := os.Getenv(env); v != "" {
+			// Accept both a bare host and a URL
+			if _, rest, found := strings.Cut(v, "://"); found {
+				v = rest
+			}
+			host, _, _ := strings.Cut(v, "/")
+			hosts = append(hosts, host)
+		}
+	}
+
+	dir := os.Getenv("GLAB_CONFIG_DIR")
+	if dir == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return hosts
+		}
+		dir = filepath.Join(configDir, "glab-cli")
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "config.yml"))
+	if err != nil {
+		return hosts
+	}
+	var config struct {
+		Hosts map[string]any `yaml:"hosts"`
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return hosts
+	}
+	for host := range config.Hosts {
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+// splitRemoteURL splits a git remote URL into its host and repository path
+// (without .git), e.g. "gitlab.com" and "group/subgroup/repo". Supports SSH
+// (git@host:path, ssh://git@host:port/path) and HTTP(S) URLs.
+func splitRemoteURL(remoteURL string) (host, path string, ok bool) {
+	url := strings.TrimSuffix(strings.TrimSpace(remoteURL), ".git")
+
+	if scheme, rest, found := strings.Cut(url, "://"); found && scheme != "" {
+		hostPart, p, _ := strings.Cut(rest, "/")
+		if i := strings.LastIndex(hostPart, "@"); i >= 0 {
+			hostPart = hostPart[i+1:]
+		}
+		if h, _, found := strings.Cut(hostPart, ":"); found {
+			hostPart = h // strip the port
+		}
+		host, path = hostPart, p
+	} else if i := strings.Index(url, ":"); i > 0 && !strings.Contains(url[:i], "/") {
+		// SCP-like SSH syntax: [user@]host:path
+		host, path = url[:i], url[i+1:]
+		if j := strings.LastIndex(host, "@"); j >= 0 {
+			host = host[j+1:]
+		}
+	} else {
+		return "", "", false
+	}
+
+	path = strings.Trim(path, "/")
+	if host == "" || !strings.Contains(path, "/") {
+		return "", "", false
+	}
+	return host, path, true
+}
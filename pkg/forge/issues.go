@@ -0,0 +1,279 @@
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Issue represents an issue on a git forge.
+type Issue struct {
+	Number int
+	Title  string
+	Body   string
+	State  string // "open" or "closed"
+	// StateReason is why a closed issue was closed: "completed" or
+	// "not_planned" (empty if unknown).
+	StateReason string
+	URL         string
+	Labels      []string
+	Milestone   *Milestone // nil if the issue has no milestone
+	// Comments are the issue's comments, oldest first. Only GetIssue
+	// fetches them.
+	Comments  []IssueComment
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IssueComment is a comment on an issue.
+type IssueComment struct {
+	Author    string // login of the comment author
+	Body      string
+	URL       string
+	CreatedAt time.Time
+}
+
+// CreateIssueOpts are the options for creating an issue.
+type CreateIssueOpts struct {
+	Title string
+	Body  string
+}
+
+// UpdateIssueOpts are the changes to make to an issue. Nil or empty fields
+// are left as they are.
+type UpdateIssueOpts struct {
+	Title *string
+	Body  *string
+	// State is "open" to reopen the issue or "closed" to close it.
+	State string
+	// StateReason is why the issue is closed: "completed" (the default) or
+	// "not_planned".
+	StateReason string
+}
+
+// Milestone represents a milestone issues are grouped under.
+type Milestone struct {
+	Number      int
+	Title       string
+	Description string
+	DueOn       *time.Time
+	URL         string // empty if unknown
+}
+
+// ghIssue is the JSON shape returned by `gh issue list --json` and
+// `gh issue view --json`.
+type ghIssue struct {
+	Number      int          `json:"number"`
+	Title       string       `json:"title"`
+	Body        string       `json:"body"`
+	State       string       `json:"state"`       // "OPEN", "CLOSED"
+	StateReason string       `json:"stateReason"` // "COMPLETED", "NOT_PLANNED", "REOPENED", ""
+	URL         string       `json:"url"`
+	Labels      []ghLabel    `json:"labels"`
+	Milestone   *ghMilestone `json:"milestone"`
+	Comments    []ghComment  `json:"comments"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+type ghLabel struct {
+	Name string `json:"name"`
+}
+
+type ghComment struct {
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ghMilestone struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	DueOn       string `json:"dueOn"` // RFC 3339, or empty
+}
+
+// GitHubIssueFields are the fields to request from `gh issue list --json`
+// for ParseGitHubIssues.
+const GitHubIssueFields = "number,title,body,state,stateReason,url,labels,milestone,createdAt,updatedAt"
+
+// ghIssueViewFields are the fields GetIssue requests from `gh issue view`.
+const ghIssueViewFields = GitHubIssueFields + ",comments"
+
+// ParseGitHubIssues parses the JSON output of `gh issue list --json` (an
+// array of issues) or `gh issue view --json` (a single issue). Fields missing
+// from the output are left empty.
+func ParseGitHubIssues(data []byte) ([]Issue, error) {
+	var raw []ghIssue
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var one ghIssue
+		if err := json.Unmarshal(trimmed, &one); err != nil {
+			return nil, fmt.Errorf("parsing GitHub issue: %w", err)
+		}
+		raw = []ghIssue{one}
+	} else if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing GitHub issues: %w", err)
+	}
+
+	issues := make([]Issue, len(raw))
+	for i, r := range raw {
+		issue, err := r.toIssue()
+		if err != nil {
+			return nil, fmt.Errorf("issue #%d: %w", r.Number, err)
+		}
+		issues[i] = issue
+	}
+	return issues, nil
+}
+
+// toIssue converts a gh issue to our Issue type.
+func (r ghIssue) toIssue() (Issue, error) {
+	issue := Issue{
+		Number:      r.Number,
+		Title:       r.Title,
+		Body:        r.Body,
+		State:       normalizeState(r.State),
+		StateReason: strings.ToLower(r.StateReason),
+		URL:         r.URL,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+	for _, l := range r.Labels {
+		issue.Labels = append(issue.Labels, l.Name)
+	}
+	for _, c := range r.Comments {
+		issue.Comments = append(issue.Comments, IssueComment{
+			Author:    c.Author.Login,
+			Body:      c.Body,
+			URL:       c.URL,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+	if m := r.Milestone; m != nil && m.Title != "" {
+		issue.Milestone = &Milestone{Number: m.Number, Title: m.Title, Description: m.Description}
+		if m.DueOn != "" {
+			due, err := time.Parse(time.RFC3339, m.DueOn)
+			if err != nil {
+				return Issue{}, fmt.Errorf("invalid milestone due date %q", m.DueOn)
+			}
+			issue.Milestone.DueOn = &due
+		}
+		if repoURL := GitHubRepoURL(r.URL); repoURL != "" && m.Number > 0 {
+			issue.Milestone.URL = fmt.Sprintf("%s/milestone/%d", repoURL, m.Number)
+		}
+	}
+	return issue, nil
+}
+
+// GitHubRepoURL returns the URL of the repository a GitHub issue or pull
+// request URL belongs to (e.g. https://github.com/owner/repo for
+// https://github.com/owner/repo/issues/12), or "" if it is not one.
+func GitHubRepoURL(url string) string {
+	for _, sep := range []string{"/issues/", "/pull/"} {
+		if i := strings.LastIndex(url, sep); i > 0 {
+			if _, _, ok := ParseOwnerRepo(url[:i]); ok {
+				return url[:i]
+			}
+		}
+	}
+	return ""
+}
+
+func (g *GitHub) GetIssue(ctx context.Context, repoDir string, number int) (*Issue, error) {
+	return g.viewIssue(ctx, repoDir, strconv.Itoa(number))
+}
+
+// viewIssue fetches an issue by number or URL with `gh issue view`.
+func (g *GitHub) viewIssue(ctx context.Context, repoDir string, ref string) (*Issue, error) {
+	cmd := exec.CommandContext(ctx, "gh", "issue", "view", ref, "--json", ghIssueViewFields)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("gh issue view %s failed: %s", ref, commandError(err))
+	}
+	issues, err := ParseGitHubIssues(out)
+	if err != nil {
+		return nil, err
+	}
+	return &issues[0], nil
+}
+
+func (g *GitHub) CreateIssue(ctx context.Context, repoDir string, opts CreateIssueOpts) (*Issue, error) {
+	cmd := exec.CommandContext(ctx, "gh", "issue", "create",
+		"--title", opts.Title,
+		"--body", opts.Body,
+	)
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("gh issue create failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	// gh issue create outputs the issue URL on its last line
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return g.viewIssue(ctx, repoDir, strings.TrimSpace(lines[len(lines)-1]))
+}
+
+func (g *GitHub) UpdateIssue(ctx context.Context, repoDir string, number int, opts UpdateIssueOpts) (*Issue, error) {
+	ref := strconv.Itoa(number)
+
+	if opts.Title != nil || opts.Body != nil {
+		args := []string{"issue", "edit", ref}
+		if opts.Title != nil {
+			args = append(args, "--title", *opts.Title)
+		}
+		if opts.Body != nil {
+			args = append(args, "--body", *opts.Body)
+		}
+		if err := runGH(ctx, repoDir, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	switch opts.State {
+	case "closed":
+		reason := "completed"
+		if opts.StateReason == "not_planned" {
+			reason = "not planned"
+		}
+		if err := runGH(ctx, repoDir, "issue", "close", ref, "--reason", reason); err != nil {
+			return nil, err
+		}
+	case "open":
+		if err := runGH(ctx, repoDir, "issue", "reopen", ref); err != nil {
+			return nil, err
+		}
+	case "":
+	default:
+		return nil, fmt.Errorf("invalid issue state %q (must be open or closed)", opts.State)
+	}
+
+	return g.viewIssue(ctx, repoDir, ref)
+}
+
+// runGH runs a gh command, returning its output as the error if it fails.
+func runGH(ctx context.Context, repoDir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "gh", args...)
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("gh %s %s failed: %s", args[0], args[1], strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// commandError returns the stderr of a failed command, or the error itself
+// if there is none.
+func commandError(err error) string {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return strings.TrimSpace(string(exitErr.Stderr))
+	}
+	return err.Error()
+}
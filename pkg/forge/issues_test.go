@@ -0,0 +1,224 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseGitHubIssues(t *testing.T) {
+	data := []byte(`[
+		{
+			"number": 12,
+			"title": "Login broken",
+			"body": "Steps:\r\n1. Log in",
+			"state": "CLOSED",
+			"stateReason": "NOT_PLANNED",
+			"url": "https://github.com/acme/app/issues/12",
+			"labels": [{"id": "L1", "name": "bug", "color": "d73a4a"}, {"name": "good first issue"}],
+			"milestone": {"number": 3, "title": "v1.0", "description": "First release", "dueOn": "2025-06-30T00:00:00Z"},
+			"createdAt": "2025-01-02T10:00:00Z",
+			"updatedAt": "2025-01-03T11:00:00Z"
+		},
+		{"number": 13, "title": "No extras", "state": "OPEN", "milestone": null}
+	]`)
+
+	issues, err := ParseGitHubIssues(data)
+	if err != nil {
+		t.Fatalf("ParseGitHubIssues() error = %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("ParseGitHubIssues() returned %d issues, want 2", len(issues))
+	}
+
+	got := issues[0]
+	if got.Number != 12 || got.Title != "Login broken" || got.State != "closed" || got.StateReason != "not_planned" {
+		t.Errorf("issue = %+v", got)
+	}
+	if len(got.Labels) != 2 || got.Labels[0] != "bug" || got.Labels[1] != "good first issue" {
+		t.Errorf("Labels = %v", got.Labels)
+	}
+	if !got.UpdatedAt.Equal(time.Date(2025, 1, 3, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("UpdatedAt = %v", got.UpdatedAt)
+	}
+	m := got.Milestone
+	if m == nil || m.Title != "v1.0" || m.Description != "First release" || m.URL != "https://github.com/acme/app/milestone/3" {
+		t.Fatalf("Milestone = %+v", m)
+	}
+	if m.DueOn == nil || !m.DueOn.Equal(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Milestone.DueOn = %v", m.DueOn)
+	}
+
+	if issues[1].Milestone != nil || issues[1].State != "open" || issues[1].URL != "" {
+		t.Errorf("issue without extras = %+v", issues[1])
+	}
+}
+
+func TestParseGitHubIssues_SingleIssue(t *testing.T) {
+	issues, err := ParseGitHubIssues([]byte(`{"number": 7, "title": "One", "state": "OPEN"}`))
+	if err != nil {
+		t.Fatalf("ParseGitHubIssues() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Number != 7 {
+		t.Errorf("ParseGitHubIssues() = %+v, want issue #7", issues)
+	}
+}
+
+func TestParseGitHubIssues_Invalid(t *testing.T) {
+	for _, data := range []string{`not json`, `[{"number": 1, "milestone": {"title": "v1", "dueOn": "soon"}}]`} {
+		if _, err := ParseGitHubIssues([]byte(data)); err == nil {
+			t.Errorf("ParseGitHubIssues(%s) error = nil", data)
+		}
+	}
+}
+
+func TestGitHubRepoURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/acme/app/issues/12", "https://github.com/acme/app"},
+		{"https://github.example.com/org/project/pull/3", "https://github.example.com/org/project"},
+		{"https://github.com/acme/app", ""},
+		{"https://github.com/issues/12", ""},
+		{"", ""},
+	}
+	for _, tc := range tests {
+		if got := GitHubRepoURL(tc.url); got != tc.want {
+			t.Errorf("GitHubRepoURL(%q) = %q, want %q", tc.url, got, tc.want)
+		}
+	}
+}
+
+// fakeGH puts a fake gh on PATH that logs its arguments, one invocation per
+// line, and serves `gh issue view` from the given issue JSON by number.
+// `gh issue create` prints the URL of issue 7. Returns the log file path.
+func fakeGH(t *testing.T, issues map[int]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for n, data := range issues {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("issue-%d.json", n)), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	script := `#!/bin/sh
+echo "$*" >> "` + dir + `/log"
+case "$1 $2" in
+"issue view") cat "` + dir + `/issue-${3##*/}.json" ;;
+"issue create") echo "Creating issue in acme/app"; echo "https://github.com/acme/app/issues/7" ;;
+"issue edit"|"issue close"|"issue reopen") ;;
+*) echo "unexpected gh $*" >&2; exit 1 ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(dir, "gh"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return filepath.Join(dir, "log")
+}
+
+// ghCalls returns the logged invocations of the fake gh.
+func ghCalls(t *testing.T, log string) []string {
+	t.Helper()
+	data, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+const testIssueJSON = `{
+	"number": 7,
+	"title": "Login broken",
+	"body": "Steps",
+	"state": "OPEN",
+	"url": "https://github.com/acme/app/issues/7",
+	"comments": [
+		{"author": {"login": "octocat"}, "body": "Same here", "url": "https://github.com/acme/app/issues/7#issuecomment-1", "createdAt": "2025-01-04T09:00:00Z"}
+	],
+	"createdAt": "2025-01-02T10:00:00Z",
+	"updatedAt": "2025-01-04T09:00:00Z"
+}`
+
+func TestGitHubGetIssue(t *testing.T) {
+	log := fakeGH(t, map[int]string{7: testIssueJSON})
+
+	issue, err := (&GitHub{}).GetIssue(context.Background(), t.TempDir(), 7)
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if issue.Number != 7 || issue.Title != "Login broken" || issue.State != "open" {
+		t.Errorf("GetIssue() = %+v", issue)
+	}
+	want := IssueComment{
+		Author:    "octocat",
+		Body:      "Same here",
+		URL:       "https://github.com/acme/app/issues/7#issuecomment-1",
+		CreatedAt: time.Date(2025, 1, 4, 9, 0, 0, 0, time.UTC),
+	}
+	if len(issue.Comments) != 1 || issue.Comments[0] != want {
+		t.Errorf("Comments = %+v, want [%+v]", issue.Comments, want)
+	}
+	if calls := ghCalls(t, log); calls[0] != "issue view 7 --json "+ghIssueViewFields {
+		t.Errorf("gh called with %q", calls[0])
+	}
+
+	if _, err := (&GitHub{}).GetIssue(context.Background(), t.TempDir(), 8); err == nil {
+		t.Error("GetIssue() of a missing issue: expected error")
+	}
+}
+
+func TestGitHubCreateIssue(t *testing.T) {
+	log := fakeGH(t, map[int]string{7: testIssueJSON})
+
+	issue, err := (&GitHub{}).CreateIssue(context.Background(), t.TempDir(), CreateIssueOpts{Title: "Login broken", Body: "Steps"})
+	if err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	if issue.Number != 7 {
+		t.Errorf("Number = %d, want 7", issue.Number)
+	}
+	want := []string{
+		"issue create --title Login broken --body Steps",
+		"issue view https://github.com/acme/app/issues/7 --json " + ghIssueViewFields,
+	}
+	if calls := ghCalls(t, log); !slices.Equal(calls, want) {
+		t.Errorf("gh calls = %q, want %q", calls, want)
+	}
+}
+
+func TestGitHubUpdateIssue(t *testing.T) {
+	title := "New title"
+	tests := []struct {
+		name string
+		opts UpdateIssueOpts
+		want []string
+	}{
+		{"title", UpdateIssueOpts{Title: &title}, []string{"issue edit 7 --title New title"}},
+		{"close as not planned", UpdateIssueOpts{State: "closed", StateReason: "not_planned"}, []string{"issue close 7 --reason not planned"}},
+		{"close", UpdateIssueOpts{State: "closed"}, []string{"issue close 7 --reason completed"}},
+		{"reopen", UpdateIssueOpts{State: "open"}, []string{"issue reopen 7"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			log := fakeGH(t, map[int]string{7: testIssueJSON})
+
+			if _, err := (&GitHub{}).UpdateIssue(context.Background(), t.TempDir(), 7, tc.opts); err != nil {
+				t.Fatalf("UpdateIssue() error = %v", err)
+			}
+			want := append(tc.want, "issue view 7 --json "+ghIssueViewFields)
+			if calls := ghCalls(t, log); !slices.Equal(calls, want) {
+				t.Errorf("gh calls = %q, want %q", calls, want)
+			}
+		})
+	}
+
+	if _, err := (&GitHub{}).UpdateIssue(context.Background(), t.TempDir(), 7, UpdateIssueOpts{State: "merged"}); err == nil {
+		t.Error("UpdateIssue() with an invalid state: expected error")
+	}
+}
@@ -0,0 +1,114 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// MergeMethod is how a pull/merge request is merged into its base branch.
+type MergeMethod string
+
+const (
+	MergeMethodDefault MergeMethod = ""       // the repository's preferred method
+	MergeMethodMerge   MergeMethod = "merge"  // merge commit
+	MergeMethodSquash  MergeMethod = "squash" // squash all commits into one
+	MergeMethodRebase  MergeMethod = "rebase" // rebase the commits onto the base branch
+)
+
+func (g *GitHub) MergePR(ctx context.Context, repoDir string, number int, method MergeMethod) error {
+	if method == MergeMethodDefault {
+		var err error
+		if method, err = g.defaultMergeMethod(ctx, repoDir); err != nil {
+			return err
+		}
+	}
+	return runGH(ctx, repoDir, "pr", "merge", strconv.Itoa(number), "--"+string(method))
+}
+
+// defaultMergeMethod returns the first merge method the repository allows,
+// preferring squash like local worktree integration.
+func (g *GitHub) defaultMergeMethod(ctx context.Context, repoDir string) (MergeMethod, error) {
+	cmd := exec.CommandContext(ctx, "gh", "repo", "view", "--json", "mergeCommitAllowed,squashMergeAllowed,rebaseMergeAllowed")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("gh repo view failed: %s", commandError(err))
+	}
+	var repo struct {
+		MergeCommitAllowed bool `json:"mergeCommitAllowed"`
+		SquashMergeAllowed bool `json:"squashMergeAllowed"`
+		RebaseMergeAllowed bool `json:"rebaseMergeAllowed"`
+	}
+	if err := json.Unmarshal(out, &repo); err != nil {
+		return "", fmt.Errorf("parsing gh repo view output: %w", err)
+	}
+	switch {
+	case repo.SquashMergeAllowed:
+		return MergeMethodSquash, nil
+	case repo.MergeCommitAllowed:
+		return MergeMethodMerge, nil
+	case repo.RebaseMergeAllowed:
+		return MergeMethodRebase, nil
+	}
+	return "", fmt.Errorf("the repository allows no merge method")
+}
+
+func (g *GitHub) ClosePR(ctx context.Context, repoDir string, number int) error {
+	return runGH(ctx, repoDir, "pr", "close", strconv.Itoa(number))
+}
+
+func (g *GitHub) RequestReview(ctx context.Context, repoDir string, number int, reviewers []string) error {
+	return runGH(ctx, repoDir, "pr", "edit", strconv.Itoa(number), "--add-reviewer", strings.Join(reviewers, ","))
+}
+
+// RerunFailedChecks re-runs the failed jobs of the GitHub Actions runs for
+// the PR's head commit. Checks reported by other CI services can't be re-run
+// through gh.
+func (g *GitHub) RerunFailedChecks(ctx context.Context, repoDir string, number int) error {
+	cmd := exec.CommandContext(ctx, "gh", "pr", "view", strconv.Itoa(number), "--json", "headRefOid")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("gh pr view failed: %s", commandError(err))
+	}
+	var pr struct {
+		HeadRefOid string `json:"headRefOid"`
+	}
+	if err := json.Unmarshal(out, &pr); err != nil {
+		return fmt.Errorf("parsing gh pr view output: %w", err)
+	}
+
+	cmd = exec.CommandContext(ctx, "gh", "run", "list", "--commit", pr.HeadRefOid, "--json", "databaseId,status,conclusion")
+	cmd.Dir = repoDir
+	if out, err = cmd.Output(); err != nil {
+		return fmt.Errorf("gh run list failed: %s", commandError(err))
+	}
+	var runs []struct {
+		DatabaseID int64  `json:"databaseId"`
+		Status     string `json:"status"`
+		Conclusion string `json:"conclusion"`
+	}
+	if err := json.Unmarshal(out, &runs); err != nil {
+		return fmt.Errorf("parsing gh run list output: %w", err)
+	}
+
+	rerun := 0
+	for _, run := range runs {
+		check := ghStatusCheck{Status: strings.ToUpper(run.Status), Conclusion: strings.ToUpper(run.Conclusion)}
+		if computeCheckStatus([]ghStatusCheck{check}) != CheckStatusFail {
+			continue
+		}
+		if err := runGH(ctx, repoDir, "run", "rerun", strconv.FormatInt(run.DatabaseID, 10), "--failed"); err != nil {
+			return err
+		}
+		rerun++
+	}
+	if rerun == 0 {
+		return fmt.Errorf("no failed workflow runs for PR #%d", number)
+	}
+	return nil
+}
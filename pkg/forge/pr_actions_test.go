@@ -0,0 +1,96 @@
+package forge
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGitHubMergePR(t *testing.T) {
+	log := fakeCLI(t, "gh", `case "$1 $2" in
+"repo view") echo '{"mergeCommitAllowed": true, "squashMergeAllowed": false, "rebaseMergeAllowed": true}' ;;
+esac`)
+	g := &GitHub{}
+
+	if err := g.MergePR(context.Background(), t.TempDir(), 12, MergeMethodRebase); err != nil {
+		t.Fatalf("MergePR() error = %v", err)
+	}
+	// Without a method, the first one the repository allows is used
+	if err := g.MergePR(context.Background(), t.TempDir(), 12, MergeMethodDefault); err != nil {
+		t.Fatalf("MergePR() with the default method error = %v", err)
+	}
+
+	want := []string{
+		"pr merge 12 --rebase",
+		"repo view --json mergeCommitAllowed,squashMergeAllowed,rebaseMergeAllowed",
+		"pr merge 12 --merge",
+	}
+	if got := ghCalls(t, log); !slices.Equal(got, want) {
+		t.Errorf("gh calls = %q, want %q", got, want)
+	}
+}
+
+func TestGitHubCloseAndRequestReview(t *testing.T) {
+	log := fakeCLI(t, "gh", "")
+	g := &GitHub{}
+
+	if err := g.ClosePR(context.Background(), t.TempDir(), 12); err != nil {
+		t.Fatalf("ClosePR() error = %v", err)
+	}
+	if err := g.RequestReview(context.Background(), t.TempDir(), 12, []string{"octocat", "hubot"}); err != nil {
+		t.Fatalf("RequestReview() error = %v", err)
+	}
+
+	want := []string{"pr close 12", "pr edit 12 --add-reviewer octocat,hubot"}
+	if got := ghCalls(t, log); !slices.Equal(got, want) {
+		t.Errorf("gh calls = %q, want %q", got, want)
+	}
+}
+
+func TestGitHubRerunFailedChecks(t *testing.T) {
+	log := fakeCLI(t, "gh", `case "$1 $2" in
+"pr view") echo '{"headRefOid": "abc123"}' ;;
+"run list") echo '[
+	{"databaseId": 1, "status": "completed", "conclusion": "failure"},
+	{"databaseId": 2, "status": "completed", "conclusion": "success"},
+	{"databaseId": 3, "status": "in_progress", "conclusion": ""},
+	{"databaseId": 4, "status": "completed", "conclusion": "timed_out"}
+]' ;;
+esac`)
+
+	if err := (&GitHub{}).RerunFailedChecks(context.Background(), t.TempDir(), 12); err != nil {
+		t.Fatalf("RerunFailedChecks() error = %v", err)
+	}
+
+	want := []string{
+		"pr view 12 --json headRefOid",
+		"run list --commit abc123 --json databaseId,status,conclusion",
+		"run rerun 1 --failed",
+		"run rerun 4 --failed",
+	}
+	if got := ghCalls(t, log); !slices.Equal(got, want) {
+		t.Errorf("gh calls = %q, want %q", got, want)
+	}
+}
+
+func TestGitHubRerunFailedChecksNoneFailed(t *testing.T) {
+	fakeCLI(t, "gh", `case "$1 $2" in
+"pr view") echo '{"headRefOid": "abc123"}' ;;
+"run list") echo '[{"databaseId": 2, "status": "completed", "conclusion": "success"}]' ;;
+esac`)
+
+	err := (&GitHub{}).RerunFailedChecks(context.Background(), t.TempDir(), 12)
+	if err == nil || !strings.Contains(err.Error(), "no failed workflow runs") {
+		t.Errorf("RerunFailedChecks() error = %v, want no failed runs", err)
+	}
+}
+
+func TestGitHubMergePRFails(t *testing.T) {
+	fakeCLI(t, "gh", `echo "Pull request #12 is not mergeable: the base branch policy prohibits the merge." >&2; exit 1`)
+
+	err := (&GitHub{}).MergePR(context.Background(), t.TempDir(), 12, MergeMethodSquash)
+	if err == nil || !strings.Contains(err.Error(), "base branch policy") {
+		t.Errorf("MergePR() error = %v, want gh's message", err)
+	}
+}